@@ -11,3 +11,217 @@
 //"二项式定理"
 
 package algebra
+
+import (
+	"errors"
+	"math/big"
+
+	"guts/maths/discrete"
+)
+
+// BinomialTerm (ax + by)ⁿ 展开式的第r+1项 T(r+1) = C(n, r)·aⁿ⁻ʳ·bʳ·xⁿ⁻ʳ·yʳ
+type BinomialTerm struct {
+	R           int
+	Binomial    *big.Int // 二项式系数 C(n, r)
+	Coefficient *big.Rat // 项的系数 C(n, r)·aⁿ⁻ʳ·bʳ
+	PowerX      int
+	PowerY      int
+}
+
+// Monomial 单项式 c·x^p，指数可为负数或分数，如 1/√x 为 {1, -1/2}
+type Monomial struct {
+	Coef  *big.Rat
+	Power *big.Rat
+}
+
+// PowerTerm (c₁x^p + c₂x^q)ⁿ 展开式的第r+1项，x的指数为 p(n-r) + qr
+type PowerTerm struct {
+	R           int
+	Coefficient *big.Rat
+	Power       *big.Rat
+}
+
+// MultinomialTerm (a₁x₁ + … + aₘxₘ)ⁿ 展开式中的一项，Exponents[i]为xᵢ的指数
+type MultinomialTerm struct {
+	Exponents   []int
+	Coefficient *big.Rat
+}
+
+var (
+	errBinomialN   = "二项式的指数须为非负整数"
+	errTermR       = "项的序号r须满足0 ≤ r ≤ n"
+	errNoSuchPower = "展开式中不含该次幂的项"
+	errNoVariables = "多项式定理至少需要一项"
+)
+
+// ratPow 有理数的非负整数次幂
+func ratPow(r *big.Rat, e int) *big.Rat {
+	num := new(big.Int).Exp(r.Num(), big.NewInt(int64(e)), nil)
+	den := new(big.Int).Exp(r.Denom(), big.NewInt(int64(e)), nil)
+	return new(big.Rat).SetFrac(num, den)
+}
+
+// GeneralTerm 二项式定理的通项 T(r+1) = C(n, r)·aⁿ⁻ʳ·bʳ·xⁿ⁻ʳ·yʳ
+func GeneralTerm(a, b *big.Rat, n, r int) (BinomialTerm, error) {
+	if n < 0 {
+		return BinomialTerm{}, errors.New(errBinomialN)
+	}
+	if r < 0 || r > n {
+		return BinomialTerm{}, errors.New(errTermR)
+	}
+	c, err := discrete.Combination(n, r)
+	if err != nil {
+		return BinomialTerm{}, err
+	}
+	coef := new(big.Rat).SetInt(c)
+	coef.Mul(coef, ratPow(a, n-r))
+	coef.Mul(coef, ratPow(b, r))
+	return BinomialTerm{R: r, Binomial: c, Coefficient: coef, PowerX: n - r, PowerY: r}, nil
+}
+
+// ExpandBinomial 展开 (ax + by)ⁿ，按y的升幂排列共n+1项
+func ExpandBinomial(a, b *big.Rat, n int) ([]BinomialTerm, error) {
+	if n < 0 {
+		return nil, errors.New(errBinomialN)
+	}
+	terms := make([]BinomialTerm, n+1)
+	for r := range terms {
+		terms[r], _ = GeneralTerm(a, b, n, r)
+	}
+	return terms, nil
+}
+
+// ExpandPowers 展开 (c₁x^p + c₂x^q)ⁿ，如 (x - 1/√x)⁶，按r升序排列，不合并同类项
+func ExpandPowers(first, second Monomial, n int) ([]PowerTerm, error) {
+	if n < 0 {
+		return nil, errors.New(errBinomialN)
+	}
+	binomials, _ := ExpandBinomial(first.Coef, second.Coef, n)
+	terms := make([]PowerTerm, n+1)
+	for r, t := range binomials {
+		power := new(big.Rat).Mul(first.Power, big.NewRat(int64(n-r), 1))
+		power.Add(power, new(big.Rat).Mul(second.Power, big.NewRat(int64(r), 1)))
+		terms[r] = PowerTerm{R: r, Coefficient: t.Coefficient, Power: power}
+	}
+	return terms, nil
+}
+
+// CoefficientOfPower 求 (c₁x^p + c₂x^q)ⁿ 展开式中x^power项的系数
+// 逐项比较x的指数 p(n-r) + qr，不存在满足条件的整数r时返回错误
+func CoefficientOfPower(first, second Monomial, n int, power *big.Rat) (*big.Rat, error) {
+	terms, err := ExpandPowers(first, second, n)
+	if err != nil {
+		return nil, err
+	}
+	// p = q 时所有项同次，需要累加
+	sum, found := new(big.Rat), false
+	for _, t := range terms {
+		if t.Power.Cmp(power) == 0 {
+			sum.Add(sum, t.Coefficient)
+			found = true
+		}
+	}
+	if !found {
+		return nil, errors.New(errNoSuchPower)
+	}
+	return sum, nil
+}
+
+// ConstantTerm 求 (c₁x^p + c₂x^q)ⁿ 展开式的常数项
+func ConstantTerm(first, second Monomial, n int) (*big.Rat, error) {
+	return CoefficientOfPower(first, second, n, new(big.Rat))
+}
+
+// LargestBinomialCoefficients 二项式系数最大的项的序号r：n为偶数时为n/2，为奇数时为(n-1)/2与(n+1)/2
+func LargestBinomialCoefficients(n int) ([]int, error) {
+	if n < 0 {
+		return nil, errors.New(errBinomialN)
+	}
+	if n%2 == 0 {
+		return []int{n / 2}, nil
+	}
+	return []int{(n - 1) / 2, (n + 1) / 2}, nil
+}
+
+// LargestCoefficient (ax + by)ⁿ 展开式中系数（含符号）最大的项，可能有多项并列
+func LargestCoefficient(a, b *big.Rat, n int) ([]BinomialTerm, error) {
+	terms, err := ExpandBinomial(a, b, n)
+	if err != nil {
+		return nil, err
+	}
+	var best []BinomialTerm
+	for _, t := range terms {
+		switch {
+		case len(best) == 0 || t.Coefficient.Cmp(best[0].Coefficient) > 0:
+			best = []BinomialTerm{t}
+		case t.Coefficient.Cmp(best[0].Coefficient) == 0:
+			best = append(best, t)
+		}
+	}
+	return best, nil
+}
+
+// SumOfCoefficients (ax + by)ⁿ 展开式各项系数之和，即令x = y = 1得 (a+b)ⁿ
+func SumOfCoefficients(a, b *big.Rat, n int) (*big.Rat, error) {
+	if n < 0 {
+		return nil, errors.New(errBinomialN)
+	}
+	return ratPow(new(big.Rat).Add(a, b), n), nil
+}
+
+// SumOfBinomialCoefficients 二项式系数之和 C(n, 0) + … + C(n, n) = 2ⁿ
+func SumOfBinomialCoefficients(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, errors.New(errBinomialN)
+	}
+	return new(big.Int).Lsh(big.NewInt(1), uint(n)), nil
+}
+
+// EvenOddCoefficientSums 赋值法：r为偶数的各项系数之和 [(a+b)ⁿ + (a-b)ⁿ]/2 与r为奇数的 [(a+b)ⁿ - (a-b)ⁿ]/2
+func EvenOddCoefficientSums(a, b *big.Rat, n int) (*big.Rat, *big.Rat, error) {
+	if n < 0 {
+		return nil, nil, errors.New(errBinomialN)
+	}
+	plus := ratPow(new(big.Rat).Add(a, b), n)
+	minus := ratPow(new(big.Rat).Sub(a, b), n)
+	half := big.NewRat(1, 2)
+	even := new(big.Rat).Add(plus, minus)
+	odd := new(big.Rat).Sub(plus, minus)
+	return even.Mul(even, half), odd.Mul(odd, half), nil
+}
+
+// MultinomialCoefficient 多项式系数 n!/(k₁!…kₘ!)，n = k₁ + … + kₘ
+func MultinomialCoefficient(ks ...int) (*big.Int, error) {
+	return discrete.MultisetPermutation(ks...)
+}
+
+// ExpandMultinomial 展开 (a₁x₁ + … + aₘxₘ)ⁿ，按指数组的字典序降序排列
+func ExpandMultinomial(coefs []*big.Rat, n int) ([]MultinomialTerm, error) {
+	if n < 0 {
+		return nil, errors.New(errBinomialN)
+	}
+	if len(coefs) == 0 {
+		return nil, errors.New(errNoVariables)
+	}
+	var terms []MultinomialTerm
+	exponents := make([]int, len(coefs))
+	var walk func(i, remaining int)
+	walk = func(i, remaining int) {
+		if i == len(coefs)-1 {
+			exponents[i] = remaining
+			c, _ := MultinomialCoefficient(exponents...)
+			coef := new(big.Rat).SetInt(c)
+			for j, e := range exponents {
+				coef.Mul(coef, ratPow(coefs[j], e))
+			}
+			terms = append(terms, MultinomialTerm{Exponents: append([]int{}, exponents...), Coefficient: coef})
+			return
+		}
+		for e := remaining; e >= 0; e-- {
+			exponents[i] = e
+			walk(i+1, remaining-e)
+		}
+	}
+	walk(0, n)
+	return terms, nil
+}
@@ -6,7 +6,10 @@
 
 package algebra
 
-import "math"
+import (
+	"errors"
+	"math"
+)
 
 type Complex struct {
 	Real      float64
@@ -15,8 +18,17 @@ type Complex struct {
 
 var (
 	errDivideByZero = "Panic: 试图除以零或者及其接近零的数"
+	errZeroDivisor  = "除数不得为零或及其接近零的复数"
+	errZeroArgument = "零的辐角与对数没有定义"
+	errZeroPower    = "零的非正数次幂没有定义"
+	errRootIndex    = "开方次数须为正整数"
 )
 
+// isZero 判断复数是否为零或极其接近零
+func isZero(a Complex) bool {
+	return a.Real*a.Real+a.Imaginary*a.Imaginary < 1e-20
+}
+
 // Add 复数加法
 func Add(a, b Complex) Complex {
 	return Complex{
@@ -25,6 +37,14 @@ func Add(a, b Complex) Complex {
 	}
 }
 
+// Subtract 复数减法
+func Subtract(a, b Complex) Complex {
+	return Complex{
+		Real:      a.Real - b.Real,
+		Imaginary: a.Imaginary - b.Imaginary,
+	}
+}
+
 // Multiply 复数乘法
 func Multiply(a, b Complex) Complex {
 	return Complex{
@@ -33,16 +53,25 @@ func Multiply(a, b Complex) Complex {
 	}
 }
 
-// Divide 复数除法
+// Divide 复数除法，除数为零时panic，需要错误返回时使用SafeDivide
 func Divide(a, b Complex) Complex {
-	denominator := b.Real*b.Real + b.Imaginary*b.Imaginary
-	if math.Abs(denominator) < 1e-10 {
+	result, err := SafeDivide(a, b)
+	if err != nil {
 		panic(errDivideByZero)
 	}
+	return result
+}
+
+// SafeDivide 复数除法，除数为零时返回错误，零的判定与isZero一致
+func SafeDivide(a, b Complex) (Complex, error) {
+	if isZero(b) {
+		return Complex{}, errors.New(errZeroDivisor)
+	}
+	denominator := b.Real*b.Real + b.Imaginary*b.Imaginary
 	return Complex{
 		Real:      (a.Real*b.Real + a.Imaginary*b.Imaginary) / denominator,
 		Imaginary: (a.Imaginary*b.Real - a.Real*b.Imaginary) / denominator,
-	}
+	}, nil
 }
 
 // Conjugate 复数共轭
@@ -57,3 +86,127 @@ func Conjugate(a Complex) Complex {
 func Modulus(a Complex) float64 {
 	return math.Sqrt(a.Real*a.Real + a.Imaginary*a.Imaginary)
 }
+
+// Argument 复数辐角主值，取值范围(-π, π]
+func Argument(a Complex) (float64, error) {
+	if isZero(a) {
+		return 0, errors.New(errZeroArgument)
+	}
+	return math.Atan2(a.Imaginary, a.Real), nil
+}
+
+// FromPolar 由三角形式 r(cosθ + i sinθ) 构造复数
+func FromPolar(r, theta float64) Complex {
+	return Complex{
+		Real:      r * math.Cos(theta),
+		Imaginary: r * math.Sin(theta),
+	}
+}
+
+// ToPolar 将复数化为三角形式，返回模长r与辐角主值θ，零的辐角记为0
+func ToPolar(a Complex) (float64, float64) {
+	return Modulus(a), math.Atan2(a.Imaginary, a.Real)
+}
+
+// Power 棣莫弗定理：[r(cosθ + i sinθ)]ⁿ = rⁿ(cos nθ + i sin nθ)
+func Power(a Complex, n int) (Complex, error) {
+	if n == 0 && !isZero(a) {
+		return Complex{Real: 1}, nil
+	}
+	if isZero(a) {
+		if n <= 0 {
+			return Complex{}, errors.New(errZeroPower)
+		}
+		return Complex{}, nil
+	}
+	r, theta := ToPolar(a)
+	return FromPolar(math.Pow(r, float64(n)), float64(n)*theta), nil
+}
+
+// Roots 复数开n次方：返回 zⁿ = a 的全部n个根，第k个根的辐角为(θ+2kπ)/n
+func Roots(a Complex, n int) ([]Complex, error) {
+	if n <= 0 {
+		return nil, errors.New(errRootIndex)
+	}
+	roots := make([]Complex, n)
+	if isZero(a) {
+		return roots, nil
+	}
+	r, theta := ToPolar(a)
+	modulus := math.Pow(r, 1/float64(n))
+	for k := 0; k < n; k++ {
+		roots[k] = FromPolar(modulus, (theta+2*math.Pi*float64(k))/float64(n))
+	}
+	return roots, nil
+}
+
+// RootsOfUnity 单位根：返回 zⁿ = 1 的全部n个根
+func RootsOfUnity(n int) ([]Complex, error) {
+	return Roots(Complex{Real: 1}, n)
+}
+
+// RationalPower 复数的有理数次幂 a^(p/q)，返回全部互不相同的取值
+func RationalPower(a Complex, p, q int) ([]Complex, error) {
+	if q == 0 {
+		return nil, errors.New(errRootIndex)
+	}
+	if q < 0 {
+		p, q = -p, -q
+	}
+	g := gcd(abs(p), q)
+	p, q = p/g, q/g
+	base, err := Power(a, p)
+	if err != nil {
+		return nil, err
+	}
+	return Roots(base, q)
+}
+
+// ComplexExp 复指数：e^(x+iy) = eˣ(cos y + i sin y)
+func ComplexExp(a Complex) Complex {
+	return FromPolar(math.Exp(a.Real), a.Imaginary)
+}
+
+// ComplexLog 复对数主值：ln z = ln|z| + i arg z
+func ComplexLog(a Complex) (Complex, error) {
+	arg, err := Argument(a)
+	if err != nil {
+		return Complex{}, err
+	}
+	return Complex{
+		Real:      math.Log(Modulus(a)),
+		Imaginary: arg,
+	}, nil
+}
+
+// ComplexSin 复正弦：sin(x+iy) = sin x cosh y + i cos x sinh y
+func ComplexSin(a Complex) Complex {
+	return Complex{
+		Real:      math.Sin(a.Real) * math.Cosh(a.Imaginary),
+		Imaginary: math.Cos(a.Real) * math.Sinh(a.Imaginary),
+	}
+}
+
+// ComplexCos 复余弦：cos(x+iy) = cos x cosh y - i sin x sinh y
+func ComplexCos(a Complex) Complex {
+	return Complex{
+		Real:      math.Cos(a.Real) * math.Cosh(a.Imaginary),
+		Imaginary: -math.Sin(a.Real) * math.Sinh(a.Imaginary),
+	}
+}
+
+// gcd 计算两个非负整数的最大公约数
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// abs 计算整数绝对值
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
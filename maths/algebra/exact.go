@@ -0,0 +1,471 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package algebra
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+
+	"guts/maths/numtheory"
+)
+
+// Surd 精确数 q₁√c₁ + q₂√c₂ + …，qᵢ为非零有理数，cᵢ为互不相同的无平方因子正整数
+// c = 1 的项即有理部分；零值表示0，输出时化为 (p₁ + p₂√c₂ + …)/d 的形式
+type Surd struct {
+	terms []SurdTerm // 按被开方数升序
+}
+
+// SurdTerm 精确数中的一项 Coef·√Radicand
+type SurdTerm struct {
+	Coef     *big.Rat
+	Radicand *big.Int
+}
+
+var (
+	errNegativeRoot = "负数不能开平方"
+	errExactZero    = "精确运算中除数为零"
+	errNotSurd      = "结果无法表示为有限个平方根之和"
+)
+
+var bigOne = big.NewInt(1)
+
+// NewRational 由有理数构造精确数
+func NewRational(r *big.Rat) Surd {
+	return fromTerms([]SurdTerm{{Coef: new(big.Rat).Set(r), Radicand: big.NewInt(1)}})
+}
+
+// NewFraction 由分子分母构造精确数 num/den
+func NewFraction(num, den int64) (Surd, error) {
+	if den == 0 {
+		return Surd{}, errors.New(errExactZero)
+	}
+	return NewRational(big.NewRat(num, den)), nil
+}
+
+// NewSurd 构造精确数 a + b√c，c须为非负整数，会自动提取平方因子
+func NewSurd(a, b *big.Rat, c int64) (Surd, error) {
+	root, err := SqrtRat(new(big.Rat).SetInt64(c))
+	if err != nil {
+		return Surd{}, err
+	}
+	return NewRational(a).Add(root.scale(b)), nil
+}
+
+// SqrtRat 有理数开平方并化为最简根式：√(p/q) = √(pq)/q
+func SqrtRat(r *big.Rat) (Surd, error) {
+	if r.Sign() < 0 {
+		return Surd{}, errors.New(errNegativeRoot)
+	}
+	if r.Sign() == 0 {
+		return Surd{}, nil
+	}
+	n := new(big.Int).Mul(r.Num(), r.Denom())
+	outside, inside, err := extractSquare(n)
+	if err != nil {
+		return Surd{}, err
+	}
+	return fromTerms([]SurdTerm{{Coef: new(big.Rat).SetFrac(outside, r.Denom()), Radicand: inside}}), nil
+}
+
+// extractSquare 将正整数n分解为 s²·c，c无平方因子
+func extractSquare(n *big.Int) (*big.Int, *big.Int, error) {
+	factors, err := numtheory.FactorizeBig(n)
+	if err != nil {
+		return nil, nil, err
+	}
+	outside, inside := big.NewInt(1), big.NewInt(1)
+	for _, f := range factors {
+		outside.Mul(outside, new(big.Int).Exp(f.Prime, big.NewInt(int64(f.Exponent/2)), nil))
+		if f.Exponent%2 == 1 {
+			inside.Mul(inside, f.Prime)
+		}
+	}
+	return outside, inside, nil
+}
+
+// fromTerms 合并被开方数相同的项、去掉零项并按被开方数排序
+func fromTerms(terms []SurdTerm) Surd {
+	merged := make(map[string]*SurdTerm)
+	var keys []string
+	for _, t := range terms {
+		if t.Coef.Sign() == 0 {
+			continue
+		}
+		key := t.Radicand.String()
+		if m, ok := merged[key]; ok {
+			m.Coef.Add(m.Coef, t.Coef)
+			continue
+		}
+		merged[key] = &SurdTerm{Coef: new(big.Rat).Set(t.Coef), Radicand: new(big.Int).Set(t.Radicand)}
+		keys = append(keys, key)
+	}
+	var s Surd
+	for _, key := range keys {
+		if m := merged[key]; m.Coef.Sign() != 0 {
+			s.terms = append(s.terms, *m)
+		}
+	}
+	slices.SortFunc(s.terms, func(a, b SurdTerm) int { return a.Radicand.Cmp(b.Radicand) })
+	return s
+}
+
+// Terms 全部非零项（按被开方数升序）的副本
+func (s Surd) Terms() []SurdTerm {
+	result := make([]SurdTerm, len(s.terms))
+	for i, t := range s.terms {
+		result[i] = SurdTerm{Coef: new(big.Rat).Set(t.Coef), Radicand: new(big.Int).Set(t.Radicand)}
+	}
+	return result
+}
+
+// Rational 有理部分
+func (s Surd) Rational() *big.Rat {
+	if len(s.terms) > 0 && s.terms[0].Radicand.Cmp(bigOne) == 0 {
+		return new(big.Rat).Set(s.terms[0].Coef)
+	}
+	return new(big.Rat)
+}
+
+// irrational 第一个无理项，不存在时返回false
+func (s Surd) irrational() (SurdTerm, bool) {
+	for _, t := range s.terms {
+		if t.Radicand.Cmp(bigOne) != 0 {
+			return t, true
+		}
+	}
+	return SurdTerm{}, false
+}
+
+// Coefficient 形如 a + b√c 时的根式系数b；含多个根式时为被开方数最小的一项的系数，全部项见Terms
+func (s Surd) Coefficient() *big.Rat {
+	if t, ok := s.irrational(); ok {
+		return new(big.Rat).Set(t.Coef)
+	}
+	return new(big.Rat)
+}
+
+// Radicand 形如 a + b√c 时的被开方数c，有理数返回1；含多个根式时为最小的被开方数
+func (s Surd) Radicand() *big.Int {
+	if t, ok := s.irrational(); ok {
+		return new(big.Int).Set(t.Radicand)
+	}
+	return big.NewInt(1)
+}
+
+// IsRational 判断是否为有理数
+func (s Surd) IsRational() bool {
+	_, ok := s.irrational()
+	return !ok
+}
+
+// IsZero 判断是否为零
+func (s Surd) IsZero() bool {
+	return len(s.terms) == 0
+}
+
+// Add 精确加法
+func (s Surd) Add(t Surd) Surd {
+	return fromTerms(append(slices.Clone(s.terms), t.terms...))
+}
+
+// Sub 精确减法
+func (s Surd) Sub(t Surd) Surd {
+	return s.Add(t.Neg())
+}
+
+// Neg 取相反数
+func (s Surd) Neg() Surd {
+	return s.scale(big.NewRat(-1, 1))
+}
+
+// Mul 精确乘法，逐项相乘：p√a·q√b = pq·g·√(ab/g²)，其中 g = gcd(a, b)
+func (s Surd) Mul(t Surd) Surd {
+	var terms []SurdTerm
+	for _, x := range s.terms {
+		for _, y := range t.terms {
+			g := new(big.Int).GCD(nil, nil, x.Radicand, y.Radicand)
+			radicand := new(big.Int).Mul(new(big.Int).Quo(x.Radicand, g), new(big.Int).Quo(y.Radicand, g))
+			coef := new(big.Rat).Mul(x.Coef, y.Coef)
+			coef.Mul(coef, new(big.Rat).SetInt(g))
+			terms = append(terms, SurdTerm{Coef: coef, Radicand: radicand})
+		}
+	}
+	return fromTerms(terms)
+}
+
+// scale 精确数乘以有理数
+func (s Surd) scale(k *big.Rat) Surd {
+	terms := make([]SurdTerm, len(s.terms))
+	for i, t := range s.terms {
+		terms[i] = SurdTerm{Coef: new(big.Rat).Mul(t.Coef, k), Radicand: t.Radicand}
+	}
+	return fromTerms(terms)
+}
+
+// splitByPrime 按是否含质因子p拆分为 u + v√p，u、v中均不再含√p
+func (s Surd) splitByPrime(p *big.Int) (Surd, Surd) {
+	var u, v []SurdTerm
+	mod := new(big.Int)
+	for _, t := range s.terms {
+		quo, _ := new(big.Int).QuoRem(t.Radicand, p, mod)
+		if mod.Sign() == 0 {
+			v = append(v, SurdTerm{Coef: t.Coef, Radicand: quo})
+		} else {
+			u = append(u, t)
+		}
+	}
+	return fromTerms(u), fromTerms(v)
+}
+
+// somePrime 任取一个无理项被开方数的质因子
+func (s Surd) somePrime() (*big.Int, error) {
+	t, _ := s.irrational()
+	factors, err := numtheory.FactorizeBig(t.Radicand)
+	if err != nil {
+		return nil, err
+	}
+	return factors[len(factors)-1].Prime, nil
+}
+
+// Quo 精确除法：逐个消去分母中的质数根式，分母 u + v√p 乘以 u - v√p 后化为 u² - v²p
+func (s Surd) Quo(t Surd) (Surd, error) {
+	if t.IsZero() {
+		return Surd{}, errors.New(errExactZero)
+	}
+	num, den := s, t
+	for !den.IsRational() {
+		p, err := den.somePrime()
+		if err != nil {
+			return Surd{}, err
+		}
+		u, v := den.splitByPrime(p)
+		conjugate := u.Sub(v.Mul(rootOf(p)))
+		num, den = num.Mul(conjugate), den.Mul(conjugate)
+	}
+	return num.scale(new(big.Rat).Inv(den.Rational())), nil
+}
+
+// rootOf 无平方因子正整数的平方根√p
+func rootOf(p *big.Int) Surd {
+	return Surd{terms: []SurdTerm{{Coef: big.NewRat(1, 1), Radicand: new(big.Int).Set(p)}}}
+}
+
+// Sqrt 精确开平方：有理数直接化简；a + b√c 在 a² - b²c 为完全平方数时可去掉嵌套根号
+// √(a + b√c) = √x ± √y，x、y = (a ± √(a² - b²c))/2，其余情况返回错误
+func (s Surd) Sqrt() (Surd, error) {
+	sign, err := s.Sign()
+	if err != nil {
+		return Surd{}, err
+	}
+	if sign < 0 {
+		return Surd{}, errors.New(errNegativeRoot)
+	}
+	if s.IsRational() {
+		return SqrtRat(s.Rational())
+	}
+	if len(s.terms) != 2 || s.terms[0].Radicand.Cmp(bigOne) != 0 {
+		return Surd{}, errors.New(errNotSurd)
+	}
+	a, b, c := s.terms[0].Coef, s.terms[1].Coef, new(big.Rat).SetInt(s.terms[1].Radicand)
+	d := new(big.Rat).Mul(a, a)
+	d.Sub(d, new(big.Rat).Mul(new(big.Rat).Mul(b, b), c))
+	if d.Sign() < 0 {
+		return Surd{}, errors.New(errNotSurd)
+	}
+	root, ok := ratRoot(d, 2)
+	if !ok {
+		return Surd{}, errors.New(errNotSurd)
+	}
+	half := big.NewRat(1, 2)
+	x := new(big.Rat).Mul(new(big.Rat).Add(a, root), half)
+	y := new(big.Rat).Mul(new(big.Rat).Sub(a, root), half)
+	sx, err := SqrtRat(x)
+	if err != nil {
+		return Surd{}, err
+	}
+	sy, err := SqrtRat(y)
+	if err != nil {
+		return Surd{}, err
+	}
+	if b.Sign() < 0 {
+		return sx.Sub(sy), nil
+	}
+	return sx.Add(sy), nil
+}
+
+// Sign 符号：正数返回1，零返回0，负数返回-1
+// 写成 u + v√p 后，u、v异号时比较 u² 与 v²p，递归消去各质数根式
+func (s Surd) Sign() (int, error) {
+	if s.IsRational() {
+		return s.Rational().Sign(), nil
+	}
+	p, err := s.somePrime()
+	if err != nil {
+		return 0, err
+	}
+	u, v := s.splitByPrime(p)
+	su, err := u.Sign()
+	if err != nil {
+		return 0, err
+	}
+	sv, err := v.Sign()
+	if err != nil {
+		return 0, err
+	}
+	if su == 0 || su == sv {
+		return sv, nil
+	}
+	if sv == 0 {
+		return su, nil
+	}
+	diff := u.Mul(u).Sub(v.Mul(v).scale(new(big.Rat).SetInt(p)))
+	sd, err := diff.Sign()
+	if err != nil {
+		return 0, err
+	}
+	switch sd {
+	case 1:
+		return su, nil
+	case -1:
+		return sv, nil
+	}
+	return 0, nil
+}
+
+// Float64 转换为浮点数近似值
+func (s Surd) Float64() float64 {
+	sum := 0.0
+	for _, t := range s.terms {
+		q, _ := t.Coef.Float64()
+		r, _ := new(big.Float).Sqrt(new(big.Float).SetInt(t.Radicand)).Float64()
+		sum += q * r
+	}
+	return sum
+}
+
+// String 输出 (p₁ + p₂√c₂ + …)/d 形式，如 1/3、√3/2、(1 + √5)/2、(√6 - √2)/4
+func (s Surd) String() string {
+	if s.IsZero() {
+		return "0"
+	}
+	den := big.NewInt(1)
+	for _, t := range s.terms {
+		g := new(big.Int).GCD(nil, nil, den, t.Coef.Denom())
+		den.Mul(den, new(big.Int).Quo(t.Coef.Denom(), g))
+	}
+	// 首项为负时把第一个正项提到最前，如 -√2 + √6 写作 √6 - √2
+	order := slices.Clone(s.terms)
+	if order[0].Coef.Sign() < 0 {
+		if i := slices.IndexFunc(order, func(t SurdTerm) bool { return t.Coef.Sign() > 0 }); i > 0 {
+			first := order[i]
+			order = slices.Insert(slices.Delete(order, i, i+1), 0, first)
+		}
+	}
+	var sb strings.Builder
+	for i, t := range order {
+		p := new(big.Int).Mul(t.Coef.Num(), new(big.Int).Quo(den, t.Coef.Denom()))
+		abs := new(big.Int).Abs(p)
+		switch {
+		case i == 0 && p.Sign() < 0:
+			sb.WriteString("-")
+		case i > 0 && p.Sign() < 0:
+			sb.WriteString(" - ")
+		case i > 0:
+			sb.WriteString(" + ")
+		}
+		if t.Radicand.Cmp(bigOne) == 0 {
+			sb.WriteString(abs.String())
+			continue
+		}
+		if abs.Cmp(bigOne) != 0 {
+			sb.WriteString(abs.String())
+		}
+		sb.WriteString("√" + t.Radicand.String())
+	}
+	body := sb.String()
+	if den.Cmp(bigOne) == 0 {
+		return body
+	}
+	if len(s.terms) > 1 {
+		body = "(" + body + ")"
+	}
+	return body + "/" + den.String()
+}
+
+// ratRoot 有理数精确开k次方，不是完全k次方时返回false
+func ratRoot(r *big.Rat, k int) (*big.Rat, bool) {
+	num, okNum := intRoot(r.Num(), k)
+	den, okDen := intRoot(r.Denom(), k)
+	if !okNum || !okDen {
+		return nil, false
+	}
+	return new(big.Rat).SetFrac(num, den), true
+}
+
+// evenRoot n为偶数时精确开n/2次方
+func evenRoot(r *big.Rat, n int) (*big.Rat, bool) {
+	if n%2 != 0 {
+		return nil, false
+	}
+	return ratRoot(r, n/2)
+}
+
+// intRoot 正整数精确开k次方，二分查找整数根
+func intRoot(n *big.Int, k int) (*big.Int, bool) {
+	lo, hi := big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), uint(n.BitLen()/k+1))
+	exp := big.NewInt(int64(k))
+	for lo.Cmp(hi) < 0 {
+		mid := new(big.Int).Add(lo, hi)
+		mid.Add(mid, big.NewInt(1)).Rsh(mid, 1)
+		if new(big.Int).Exp(mid, exp, nil).Cmp(n) <= 0 {
+			lo = mid
+		} else {
+			hi = mid.Sub(mid, big.NewInt(1))
+		}
+	}
+	return lo, new(big.Int).Exp(lo, exp, nil).Cmp(n) == 0
+}
+
+// MeanInequalitiesExact 精确计算调和、几何、算术、平方平均数
+// 几何平均数只有在能化为单一根式时才可精确表示，否则返回错误
+func MeanInequalitiesExact(u []*big.Rat) (Surd, Surd, Surd, Surd, error) {
+	if len(u) == 0 {
+		return Surd{}, Surd{}, Surd{}, Surd{}, errors.New(errEmptySet)
+	}
+	n := new(big.Rat).SetInt64(int64(len(u)))
+	harmonicSum, squareSum, arithSum := new(big.Rat), new(big.Rat), new(big.Rat)
+	geoProduct := big.NewRat(1, 1)
+	for _, num := range u {
+		if num.Sign() <= 0 {
+			return Surd{}, Surd{}, Surd{}, Surd{}, errors.New(errNonPositive)
+		}
+		harmonicSum.Add(harmonicSum, new(big.Rat).Inv(num))
+		geoProduct.Mul(geoProduct, num)
+		squareSum.Add(squareSum, new(big.Rat).Mul(num, num))
+		arithSum.Add(arithSum, num)
+	}
+	H := NewRational(new(big.Rat).Quo(n, harmonicSum))
+	A := NewRational(new(big.Rat).Quo(arithSum, n))
+	Q, err := SqrtRat(new(big.Rat).Quo(squareSum, n))
+	if err != nil {
+		return Surd{}, Surd{}, Surd{}, Surd{}, err
+	}
+	var G Surd
+	if root, ok := ratRoot(geoProduct, len(u)); ok {
+		G = NewRational(root)
+	} else if root, ok := evenRoot(geoProduct, len(u)); ok {
+		// ⁿ√x = √(ⁿᐟ²√x)
+		if G, err = SqrtRat(root); err != nil {
+			return Surd{}, Surd{}, Surd{}, Surd{}, err
+		}
+	} else {
+		return Surd{}, Surd{}, Surd{}, Surd{}, errors.New(errNotSurd)
+	}
+	return H, G, A, Q, nil
+}
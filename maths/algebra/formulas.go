@@ -15,8 +15,11 @@ var (
 	errEmptySet        = "不得传入空集"
 	errNonPositive     = "集合内需全部为正数"
 	errCauchyCondition = "实参不满足柯西求最值条件"
+	errCauchyLength    = "两组实参的个数须相同"
 )
 
+const proportionalTolerance = 1e-9 // 判断两组数成比例时允许的相对误差
+
 // CubicDifference 计算立方差：a³ - b³ = (a-b)(a²+ab+b²)
 func CubicDifference(a, b float64) float64 {
 	return (a - b) * (a*a + a*b + b*b)
@@ -38,25 +41,41 @@ func MeanInequalities(u []float64) (float64, float64, float64, float64, error) {
 		}
 	}
 	n := float64(len(u))
-	var harmonicSum, geoProduct, squareSum, arithSum float64
-	geoProduct = 1
+	var harmonicSum, logSum, squareSum, arithSum float64
 	for _, num := range u {
 		harmonicSum += 1 / num
-		geoProduct *= num
+		logSum += math.Log(num) // 取对数求和，避免连乘溢出
 		squareSum += num * num
 		arithSum += num
 	}
-	H := n / harmonicSum           // 调和平均数
-	G := math.Pow(geoProduct, 1/n) // 几何平均数
-	A := arithSum / n              // 算术平均数
-	Q := math.Sqrt(squareSum / n)  // 平方平均数
+	H := n / harmonicSum          // 调和平均数
+	G := math.Exp(logSum / n)     // 几何平均数
+	A := arithSum / n             // 算术平均数
+	Q := math.Sqrt(squareSum / n) // 平方平均数
 	return H, G, A, Q, nil
 }
 
-// CauchyEquality 检查柯西不等式等号条件并计算对应值
-func CauchyEquality(a, b, c, d float64) (float64, error) {
-	if a*d != b*c {
+// CauchyEquality 检查n维柯西不等式 (Σaᵢbᵢ)² ≤ (Σaᵢ²)(Σbᵢ²) 的等号条件并计算对应值
+// 等号成立当且仅当两组数对应成比例，用拉格朗日恒等式 Σ(aᵢbⱼ-aⱼbᵢ)² = (Σaᵢ²)(Σbᵢ²) - (Σaᵢbᵢ)² 按相对误差判断
+func CauchyEquality(a, b []float64) (float64, error) {
+	if len(a) == 0 {
+		return 0, errors.New(errEmptySet)
+	}
+	if len(a) != len(b) {
+		return 0, errors.New(errCauchyLength)
+	}
+	var dot, aa, bb, gap float64
+	for i := range a {
+		dot += a[i] * b[i]
+		aa += a[i] * a[i]
+		bb += b[i] * b[i]
+		for j := i + 1; j < len(a); j++ {
+			cross := a[i]*b[j] - a[j]*b[i]
+			gap += cross * cross
+		}
+	}
+	if !(gap <= proportionalTolerance*proportionalTolerance*aa*bb) {
 		return 0, errors.New(errCauchyCondition)
 	}
-	return math.Pow(a*c+b*d, 2), nil
+	return dot * dot, nil
 }
@@ -9,6 +9,8 @@ package algebra
 import (
 	"errors"
 	"math"
+
+	"guts/maths/sets"
 )
 
 var (
@@ -28,6 +30,14 @@ func CheckLogValidity(base, x float64) (bool, error) {
 	return true, nil
 }
 
+// LogDomain 以base为底的对数函数的定义域(0, +∞)，底数无效时返回错误
+func LogDomain(base float64) (sets.IntervalSet, error) {
+	if ok, err := CheckLogValidity(base, 1); !ok {
+		return sets.IntervalSet{}, err
+	}
+	return sets.NewIntervalSet(sets.Open(0, math.Inf(1))), nil
+}
+
 // Log 计算以base为底x的对数
 func Log(base, x float64) (float64, error) {
 	if ok, err := CheckLogValidity(base, x); !ok {
@@ -0,0 +1,487 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package algebra
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Polynomial 实系数多项式，下标i处为xⁱ的系数
+type Polynomial []float64
+
+// ComplexPolynomial 复系数多项式，下标i处为xⁱ的系数
+type ComplexPolynomial []Complex
+
+const polyEpsilon = 1e-10 // 多项式系数视为零的阈值
+
+var (
+	errZeroPolynomial = "除式不得为零多项式"
+	errConstantPoly   = "零次多项式没有可求的根"
+	errDegreeTooHigh  = "五次及以上方程没有求根公式，请使用Roots"
+	errNotConverged   = "数值求根未能在迭代次数内收敛"
+)
+
+// Degree 多项式次数，零多项式返回-1
+func (p Polynomial) Degree() int {
+	for i := len(p) - 1; i >= 0; i-- {
+		if math.Abs(p[i]) > polyEpsilon {
+			return i
+		}
+	}
+	return -1
+}
+
+// trim 去掉高次的零系数
+func (p Polynomial) trim() Polynomial {
+	return p[:p.Degree()+1]
+}
+
+// Add 多项式加法
+func (p Polynomial) Add(q Polynomial) Polynomial {
+	result := make(Polynomial, max(len(p), len(q)))
+	for i := range result {
+		if i < len(p) {
+			result[i] += p[i]
+		}
+		if i < len(q) {
+			result[i] += q[i]
+		}
+	}
+	return result.trim()
+}
+
+// Sub 多项式减法
+func (p Polynomial) Sub(q Polynomial) Polynomial {
+	return p.Add(q.Scale(-1))
+}
+
+// Scale 多项式数乘
+func (p Polynomial) Scale(k float64) Polynomial {
+	result := make(Polynomial, len(p))
+	for i, c := range p {
+		result[i] = c * k
+	}
+	return result.trim()
+}
+
+// Mul 多项式乘法
+func (p Polynomial) Mul(q Polynomial) Polynomial {
+	p, q = p.trim(), q.trim()
+	if len(p) == 0 || len(q) == 0 {
+		return Polynomial{}
+	}
+	result := make(Polynomial, len(p)+len(q)-1)
+	for i, a := range p {
+		for j, b := range q {
+			result[i+j] += a * b
+		}
+	}
+	return result.trim()
+}
+
+// DivMod 多项式带余除法：p = q·商 + 余式，且余式次数小于q的次数
+func (p Polynomial) DivMod(q Polynomial) (Polynomial, Polynomial, error) {
+	q = q.trim()
+	if len(q) == 0 {
+		return nil, nil, errors.New(errZeroPolynomial)
+	}
+	rem := append(Polynomial{}, p.trim()...)
+	if len(rem) < len(q) {
+		return Polynomial{}, rem, nil
+	}
+	quot := make(Polynomial, len(rem)-len(q)+1)
+	lead := q[len(q)-1]
+	for i := len(quot) - 1; i >= 0; i-- {
+		coef := rem[i+len(q)-1] / lead
+		quot[i] = coef
+		for j, c := range q {
+			rem[i+j] -= coef * c
+		}
+	}
+	return quot.trim(), rem[:len(q)-1].trim(), nil
+}
+
+// PolynomialGCD 多项式的最大公因式（首项系数化为1）
+func PolynomialGCD(p, q Polynomial) Polynomial {
+	a, b := p.trim(), q.trim()
+	for len(b) > 0 {
+		_, rem, _ := a.DivMod(b)
+		a, b = b, rem
+	}
+	if len(a) == 0 {
+		return Polynomial{}
+	}
+	return a.Scale(1 / a[len(a)-1])
+}
+
+// Eval 秦九韶算法(Horner)求多项式在x处的值
+func (p Polynomial) Eval(x float64) float64 {
+	var result float64
+	for i := len(p) - 1; i >= 0; i-- {
+		result = result*x + p[i]
+	}
+	return result
+}
+
+// EvalComplex 秦九韶算法求多项式在复数z处的值
+func (p Polynomial) EvalComplex(z Complex) Complex {
+	return p.ToComplex().Eval(z)
+}
+
+// Derivative 多项式求导
+func (p Polynomial) Derivative() Polynomial {
+	if len(p) <= 1 {
+		return Polynomial{}
+	}
+	result := make(Polynomial, len(p)-1)
+	for i := 1; i < len(p); i++ {
+		result[i-1] = float64(i) * p[i]
+	}
+	return result.trim()
+}
+
+// Equal 判断两多项式在给定误差内是否恒等
+func (p Polynomial) Equal(q Polynomial, tolerance float64) bool {
+	diff := p.Sub(q)
+	for _, c := range diff {
+		if math.Abs(c) > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+// ToComplex 转为复系数多项式
+func (p Polynomial) ToComplex() ComplexPolynomial {
+	result := make(ComplexPolynomial, len(p))
+	for i, c := range p {
+		result[i] = Complex{Real: c}
+	}
+	return result
+}
+
+// String 按降幂输出多项式，如 2x^3 - x + 1
+func (p Polynomial) String() string {
+	p = p.trim()
+	if len(p) == 0 {
+		return "0"
+	}
+	var sb strings.Builder
+	for i := len(p) - 1; i >= 0; i-- {
+		c := p[i]
+		if c == 0 {
+			continue
+		}
+		switch {
+		case sb.Len() == 0 && c < 0:
+			sb.WriteString("-")
+		case sb.Len() > 0 && c < 0:
+			sb.WriteString(" - ")
+		case sb.Len() > 0:
+			sb.WriteString(" + ")
+		}
+		c = math.Abs(c)
+		if c != 1 || i == 0 {
+			sb.WriteString(fmt.Sprint(c))
+		}
+		switch {
+		case i == 1:
+			sb.WriteString("x")
+		case i > 1:
+			fmt.Fprintf(&sb, "x^%d", i)
+		}
+	}
+	return sb.String()
+}
+
+// Solve 用求根公式求四次及以下方程 p(x) = 0 的全部复数根（含重根）
+func (p Polynomial) Solve() ([]Complex, error) {
+	p = p.trim()
+	n := len(p) - 1
+	if n < 1 {
+		return nil, errors.New(errConstantPoly)
+	}
+	if n > 4 {
+		return nil, errors.New(errDegreeTooHigh)
+	}
+	monic := p.Scale(1 / p[n])
+	var roots []Complex
+	switch n {
+	case 1:
+		roots = []Complex{{Real: -monic[0]}}
+	case 2:
+		roots = solveQuadratic(Complex{Real: monic[1]}, Complex{Real: monic[0]})
+	case 3:
+		roots = solveCubic(monic[2], monic[1], monic[0])
+	case 4:
+		roots = solveQuartic(monic[3], monic[2], monic[1], monic[0])
+	}
+	for i := range roots {
+		roots[i] = cleanComplex(roots[i])
+	}
+	return roots, nil
+}
+
+// Roots 用Durand–Kerner迭代求多项式的全部复数根
+func (p Polynomial) Roots() ([]Complex, error) {
+	return p.ToComplex().Roots()
+}
+
+// solveQuadratic 求首一二次方程 x² + bx + c = 0 的两根
+func solveQuadratic(b, c Complex) []Complex {
+	half := scaleComplex(b, -0.5)
+	disc := Subtract(Multiply(half, half), c)
+	sq := complexSqrt(disc)
+	return []Complex{Add(half, sq), Subtract(half, sq)}
+}
+
+// solveCubic 卡尔达诺公式求首一三次方程 x³ + ax² + bx + c = 0 的三根
+func solveCubic(a, b, c float64) []Complex {
+	// 代换 x = t - a/3 化为 t³ + pt + q = 0
+	p := b - a*a/3
+	q := 2*a*a*a/27 - a*b/3 + c
+	shift := Complex{Real: -a / 3}
+	if math.Abs(p) < polyEpsilon && math.Abs(q) < polyEpsilon {
+		return []Complex{shift, shift, shift}
+	}
+	disc := complexSqrt(Complex{Real: q*q/4 + p*p*p/27})
+	w := Subtract(Complex{Real: -q / 2}, disc)
+	if Modulus(w) < polyEpsilon {
+		w = Add(Complex{Real: -q / 2}, disc)
+	}
+	cubeRoots, _ := Roots(w, 3)
+	roots := make([]Complex, 3)
+	for k, u := range cubeRoots {
+		v, _ := SafeDivide(Complex{Real: -p / 3}, u)
+		roots[k] = Add(Add(u, v), shift)
+	}
+	return roots
+}
+
+// solveQuartic 费拉里方法求首一四次方程 x⁴ + ax³ + bx² + cx + d = 0 的四根
+func solveQuartic(a, b, c, d float64) []Complex {
+	// 代换 x = y - a/4 化为 y⁴ + py² + qy + r = 0
+	p := b - 3*a*a/8
+	q := a*a*a/8 - a*b/2 + c
+	r := -3*a*a*a*a/256 + a*a*b/16 - a*c/4 + d
+	shift := Complex{Real: -a / 4}
+	var ys []Complex
+	if math.Abs(q) < polyEpsilon {
+		// 双二次方程：y² 满足 z² + pz + r = 0
+		for _, z := range solveQuadratic(Complex{Real: p}, Complex{Real: r}) {
+			s := complexSqrt(z)
+			ys = append(ys, s, scaleComplex(s, -1))
+		}
+	} else {
+		// 预解三次方程 8m³ + 8pm² + (2p² - 8r)m - q² = 0，取模最大的根保证m非零
+		var m Complex
+		for _, root := range solveCubic(p, p*p/4-r, -q*q/8) {
+			if Modulus(root) > Modulus(m) {
+				m = root
+			}
+		}
+		s := complexSqrt(scaleComplex(m, 2))
+		for _, sign := range []float64{1, -1} {
+			t, _ := SafeDivide(Complex{Real: 2 * q}, s)
+			inner := scaleComplex(Add(Add(Complex{Real: 2 * p}, scaleComplex(m, 2)), scaleComplex(t, sign)), -1)
+			sq := complexSqrt(inner)
+			base := scaleComplex(s, sign)
+			ys = append(ys, scaleComplex(Add(base, sq), 0.5), scaleComplex(Subtract(base, sq), 0.5))
+		}
+	}
+	for i := range ys {
+		ys[i] = Add(ys[i], shift)
+	}
+	return ys
+}
+
+// Degree 复系数多项式次数，零多项式返回-1
+func (p ComplexPolynomial) Degree() int {
+	for i := len(p) - 1; i >= 0; i-- {
+		if Modulus(p[i]) > polyEpsilon {
+			return i
+		}
+	}
+	return -1
+}
+
+// trim 去掉高次的零系数
+func (p ComplexPolynomial) trim() ComplexPolynomial {
+	return p[:p.Degree()+1]
+}
+
+// Add 复系数多项式加法
+func (p ComplexPolynomial) Add(q ComplexPolynomial) ComplexPolynomial {
+	result := make(ComplexPolynomial, max(len(p), len(q)))
+	for i := range result {
+		if i < len(p) {
+			result[i] = Add(result[i], p[i])
+		}
+		if i < len(q) {
+			result[i] = Add(result[i], q[i])
+		}
+	}
+	return result.trim()
+}
+
+// Sub 复系数多项式减法
+func (p ComplexPolynomial) Sub(q ComplexPolynomial) ComplexPolynomial {
+	neg := make(ComplexPolynomial, len(q))
+	for i, c := range q {
+		neg[i] = scaleComplex(c, -1)
+	}
+	return p.Add(neg)
+}
+
+// Mul 复系数多项式乘法
+func (p ComplexPolynomial) Mul(q ComplexPolynomial) ComplexPolynomial {
+	p, q = p.trim(), q.trim()
+	if len(p) == 0 || len(q) == 0 {
+		return ComplexPolynomial{}
+	}
+	result := make(ComplexPolynomial, len(p)+len(q)-1)
+	for i, a := range p {
+		for j, b := range q {
+			result[i+j] = Add(result[i+j], Multiply(a, b))
+		}
+	}
+	return result.trim()
+}
+
+// DivMod 复系数多项式带余除法
+func (p ComplexPolynomial) DivMod(q ComplexPolynomial) (ComplexPolynomial, ComplexPolynomial, error) {
+	q = q.trim()
+	if len(q) == 0 {
+		return nil, nil, errors.New(errZeroPolynomial)
+	}
+	rem := append(ComplexPolynomial{}, p.trim()...)
+	if len(rem) < len(q) {
+		return ComplexPolynomial{}, rem, nil
+	}
+	quot := make(ComplexPolynomial, len(rem)-len(q)+1)
+	lead := q[len(q)-1]
+	for i := len(quot) - 1; i >= 0; i-- {
+		coef, err := SafeDivide(rem[i+len(q)-1], lead)
+		if err != nil {
+			return nil, nil, err
+		}
+		quot[i] = coef
+		for j, c := range q {
+			rem[i+j] = Subtract(rem[i+j], Multiply(coef, c))
+		}
+	}
+	return quot.trim(), rem[:len(q)-1].trim(), nil
+}
+
+// Eval 秦九韶算法求复系数多项式在z处的值
+func (p ComplexPolynomial) Eval(z Complex) Complex {
+	var result Complex
+	for i := len(p) - 1; i >= 0; i-- {
+		result = Add(Multiply(result, z), p[i])
+	}
+	return result
+}
+
+// Derivative 复系数多项式求导
+func (p ComplexPolynomial) Derivative() ComplexPolynomial {
+	if len(p) <= 1 {
+		return ComplexPolynomial{}
+	}
+	result := make(ComplexPolynomial, len(p)-1)
+	for i := 1; i < len(p); i++ {
+		result[i-1] = scaleComplex(p[i], float64(i))
+	}
+	return result.trim()
+}
+
+// Roots 用Durand–Kerner迭代求复系数多项式的全部复数根
+func (p ComplexPolynomial) Roots() ([]Complex, error) {
+	p = p.trim()
+	n := len(p) - 1
+	if n < 1 {
+		return nil, errors.New(errConstantPoly)
+	}
+	monic := make(ComplexPolynomial, len(p))
+	for i, c := range p {
+		v, err := SafeDivide(c, p[n])
+		if err != nil {
+			return nil, err
+		}
+		monic[i] = v
+	}
+	roots := make([]Complex, n)
+	seed := Complex{Real: 0.4, Imaginary: 0.9}
+	roots[0] = Complex{Real: 1}
+	for i := 1; i < n; i++ {
+		roots[i] = Multiply(roots[i-1], seed)
+	}
+	for iter := 0; iter < 1000; iter++ {
+		converged := true
+		for i := range roots {
+			denominator := Complex{Real: 1}
+			for j := range roots {
+				if i != j {
+					denominator = Multiply(denominator, Subtract(roots[i], roots[j]))
+				}
+			}
+			var step Complex
+			if denominator.Real == 0 && denominator.Imaginary == 0 {
+				// 两个近似根完全重合时轻微扰动后继续迭代
+				step = Complex{Real: 1e-8, Imaginary: 1e-8}
+			} else {
+				// 重根附近分母极小，不能套用Divide的零判定阈值
+				norm := denominator.Real*denominator.Real + denominator.Imaginary*denominator.Imaginary
+				step = scaleComplex(Multiply(monic.Eval(roots[i]), Conjugate(denominator)), 1/norm)
+			}
+			roots[i] = Subtract(roots[i], step)
+			if Modulus(step) > 1e-13*math.Max(1, Modulus(roots[i])) {
+				converged = false
+			}
+		}
+		if converged {
+			break
+		}
+	}
+	// 重根处迭代只线性收敛，以残差作为最终判据
+	for i := range roots {
+		scale := 0.0
+		for _, c := range monic {
+			scale += Modulus(c)
+		}
+		scale *= math.Pow(math.Max(1, Modulus(roots[i])), float64(n))
+		if Modulus(monic.Eval(roots[i])) > 1e-8*scale {
+			return nil, errors.New(errNotConverged)
+		}
+		roots[i] = cleanComplex(roots[i])
+	}
+	return roots, nil
+}
+
+// complexSqrt 复数平方根主值
+func complexSqrt(a Complex) Complex {
+	roots, _ := Roots(a, 2)
+	return roots[0]
+}
+
+// scaleComplex 复数数乘
+func scaleComplex(a Complex, k float64) Complex {
+	return Complex{Real: a.Real * k, Imaginary: a.Imaginary * k}
+}
+
+// cleanComplex 将极小的实部或虚部置零，避免输出 1e-17i 之类的噪声
+func cleanComplex(a Complex) Complex {
+	if math.Abs(a.Real) < 1e-9 {
+		a.Real = 0
+	}
+	if math.Abs(a.Imaginary) < 1e-9 {
+		a.Imaginary = 0
+	}
+	return a
+}
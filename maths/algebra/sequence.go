@@ -12,3 +12,248 @@
 //"递推数列通项公式求法"
 
 package algebra
+
+import (
+	"errors"
+	"math"
+)
+
+// ArithmeticSequence 等差数列：aₙ = a₁ + (n-1)d
+type ArithmeticSequence struct {
+	First      float64
+	Difference float64
+}
+
+// GeometricSequence 等比数列：aₙ = a₁·qⁿ⁻¹
+type GeometricSequence struct {
+	First float64
+	Ratio float64
+}
+
+// FirstOrderRecurrence 一阶线性递推：aₙ = p·aₙ₋₁ + q
+type FirstOrderRecurrence struct {
+	First float64
+	P     float64
+	Q     float64
+}
+
+// SecondOrderRecurrence 二阶线性递推：aₙ = p·aₙ₋₁ + q·aₙ₋₂
+type SecondOrderRecurrence struct {
+	First  float64
+	Second float64
+	P      float64
+	Q      float64
+}
+
+var (
+	errTermIndex     = "项数须为正整数"
+	errSameIndex     = "两个已知项的项数不得相同"
+	errZeroGeometric = "等比数列的项不得为零"
+	errNoRatio       = "不存在满足条件的实数公比"
+	errZeroTerm      = "裂项的分母中出现了零项"
+	errSplitGap      = "裂项间隔须为正整数且公差不得为零"
+)
+
+// Term 等差数列通项公式
+func (s ArithmeticSequence) Term(n int) (float64, error) {
+	if n < 1 {
+		return 0, errors.New(errTermIndex)
+	}
+	return s.First + float64(n-1)*s.Difference, nil
+}
+
+// Sum 等差数列前n项和：Sₙ = na₁ + n(n-1)d/2
+func (s ArithmeticSequence) Sum(n int) (float64, error) {
+	if n < 1 {
+		return 0, errors.New(errTermIndex)
+	}
+	return float64(n)*s.First + float64(n)*float64(n-1)*s.Difference/2, nil
+}
+
+// ArithmeticFromTerms 由第m项与第n项确定等差数列
+func ArithmeticFromTerms(m int, am float64, n int, an float64) (ArithmeticSequence, error) {
+	if m < 1 || n < 1 {
+		return ArithmeticSequence{}, errors.New(errTermIndex)
+	}
+	if m == n {
+		return ArithmeticSequence{}, errors.New(errSameIndex)
+	}
+	d := (an - am) / float64(n-m)
+	return ArithmeticSequence{First: am - float64(m-1)*d, Difference: d}, nil
+}
+
+// Term 等比数列通项公式
+func (s GeometricSequence) Term(n int) (float64, error) {
+	if n < 1 {
+		return 0, errors.New(errTermIndex)
+	}
+	return s.First * math.Pow(s.Ratio, float64(n-1)), nil
+}
+
+// Sum 等比数列前n项和：q=1时Sₙ = na₁，否则Sₙ = a₁(1-qⁿ)/(1-q)
+func (s GeometricSequence) Sum(n int) (float64, error) {
+	if n < 1 {
+		return 0, errors.New(errTermIndex)
+	}
+	if s.Ratio == 1 {
+		return float64(n) * s.First, nil
+	}
+	return s.First * (1 - math.Pow(s.Ratio, float64(n))) / (1 - s.Ratio), nil
+}
+
+// GeometricFromTerms 由第m项与第n项确定等比数列，项数差为偶数时公比可正可负，返回全部可能
+func GeometricFromTerms(m int, am float64, n int, an float64) ([]GeometricSequence, error) {
+	if m < 1 || n < 1 {
+		return nil, errors.New(errTermIndex)
+	}
+	if m == n {
+		return nil, errors.New(errSameIndex)
+	}
+	if am == 0 || an == 0 {
+		return nil, errors.New(errZeroGeometric)
+	}
+	// aₙ/aₘ = qⁿ⁻ᵐ，项数差可为负，此时 q = (aₙ/aₘ)^(1/(n-m)) 同样成立
+	gap := n - m
+	power := an / am
+	var ratios []float64
+	if gap%2 == 0 {
+		if power < 0 {
+			return nil, errors.New(errNoRatio)
+		}
+		q := math.Pow(power, 1/float64(gap))
+		ratios = []float64{q, -q}
+	} else {
+		q := math.Pow(math.Abs(power), 1/float64(gap))
+		if power < 0 {
+			q = -q
+		}
+		ratios = []float64{q}
+	}
+	result := make([]GeometricSequence, len(ratios))
+	for i, q := range ratios {
+		result[i] = GeometricSequence{First: am / math.Pow(q, float64(m-1)), Ratio: q}
+	}
+	return result, nil
+}
+
+// Term 一阶线性递推的通项：p≠1时构造等比数列 aₙ-λ = (a₁-λ)pⁿ⁻¹，其中λ = q/(1-p)
+func (r FirstOrderRecurrence) Term(n int) (float64, error) {
+	if n < 1 {
+		return 0, errors.New(errTermIndex)
+	}
+	if r.P == 1 {
+		return ArithmeticSequence{First: r.First, Difference: r.Q}.Term(n)
+	}
+	lambda := r.Q / (1 - r.P)
+	return (r.First-lambda)*math.Pow(r.P, float64(n-1)) + lambda, nil
+}
+
+// CharacteristicRoots 二阶线性递推的特征方程 x² = px + q 的两根
+func (r SecondOrderRecurrence) CharacteristicRoots() (Complex, Complex) {
+	roots := solveQuadratic(Complex{Real: -r.P}, Complex{Real: -r.Q})
+	return cleanComplex(roots[0]), cleanComplex(roots[1])
+}
+
+// Term 特征根法求二阶线性递推的通项
+// 两根相异时 aₙ = c₁x₁ⁿ⁻¹ + c₂x₂ⁿ⁻¹，两根相等时 aₙ = (c₁ + c₂(n-1))xⁿ⁻¹
+func (r SecondOrderRecurrence) Term(n int) (float64, error) {
+	if n < 1 {
+		return 0, errors.New(errTermIndex)
+	}
+	if n == 1 {
+		return r.First, nil
+	}
+	if n == 2 {
+		return r.Second, nil
+	}
+	x1, x2 := r.CharacteristicRoots()
+	a1 := Complex{Real: r.First}
+	a2 := Complex{Real: r.Second}
+	if Modulus(Subtract(x1, x2)) < 1e-12 {
+		if isZero(x1) {
+			return 0, nil
+		}
+		c1 := a1
+		ratio, err := SafeDivide(a2, x1)
+		if err != nil {
+			return 0, err
+		}
+		c2 := Subtract(ratio, a1)
+		xn, _ := Power(x1, n-1)
+		coef := Add(c1, scaleComplex(c2, float64(n-1)))
+		return Multiply(coef, xn).Real, nil
+	}
+	c2, err := SafeDivide(Subtract(a2, Multiply(a1, x1)), Subtract(x2, x1))
+	if err != nil {
+		return 0, err
+	}
+	c1 := Subtract(a1, c2)
+	// 特征根可能为零，零的正整数次幂仍有定义
+	p1, _ := Power(x1, n-1)
+	p2, _ := Power(x2, n-1)
+	return Add(Multiply(c1, p1), Multiply(c2, p2)).Real, nil
+}
+
+// TelescopingSum 裂项相消：Σ[g(k) - g(k+gap)]，k从from到to
+// 中间项相互抵消，只剩前gap项与后gap项
+func TelescopingSum(g func(k int) float64, from, to, gap int) (float64, error) {
+	if gap < 1 {
+		return 0, errors.New(errSplitGap)
+	}
+	if to < from {
+		return 0, nil
+	}
+	var head, tail float64
+	for k := from; k < from+gap; k++ {
+		head += g(k)
+	}
+	for k := to + 1; k <= to+gap; k++ {
+		tail += g(k)
+	}
+	return head - tail, nil
+}
+
+// SplitTermSum 裂项求和：Σ 1/(aₖ·aₖ₊gap)，k从1到n
+// 利用 1/(aₖ·aₖ₊gap) = [1/aₖ - 1/aₖ₊gap]/(gap·d)
+func (s ArithmeticSequence) SplitTermSum(gap, n int) (float64, error) {
+	if gap < 1 || s.Difference == 0 {
+		return 0, errors.New(errSplitGap)
+	}
+	if n < 1 {
+		return 0, errors.New(errTermIndex)
+	}
+	for k := 1; k <= n+gap; k++ {
+		term, _ := s.Term(k)
+		if term == 0 {
+			return 0, errors.New(errZeroTerm)
+		}
+	}
+	sum, err := TelescopingSum(func(k int) float64 {
+		term, _ := s.Term(k)
+		return 1 / term
+	}, 1, n, gap)
+	if err != nil {
+		return 0, err
+	}
+	return sum / (float64(gap) * s.Difference), nil
+}
+
+// ArithmeticGeometricSum 错位相减法：等差数列{aₖ}与等比数列{bₖ}对应项乘积的前n项和
+// Sₙ - qSₙ = a₁b₁ + d·(b₂ + … + bₙ) - aₙbₙ₊₁
+func ArithmeticGeometricSum(a ArithmeticSequence, b GeometricSequence, n int) (float64, error) {
+	if n < 1 {
+		return 0, errors.New(errTermIndex)
+	}
+	q := b.Ratio
+	if q == 1 {
+		sum, _ := a.Sum(n)
+		return b.First * sum, nil
+	}
+	an, _ := a.Term(n)
+	bn1, _ := b.Term(n + 1)
+	middle := 0.0
+	if n > 1 {
+		middle, _ = GeometricSequence{First: b.First * q, Ratio: q}.Sum(n - 1)
+	}
+	return (a.First*b.First + a.Difference*middle - an*bn1) / (1 - q), nil
+}
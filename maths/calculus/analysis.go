@@ -0,0 +1,241 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package calculus
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"guts/maths/expr"
+	"guts/maths/sets"
+	"guts/maths/solver"
+)
+
+// Extremum 极值点或最值点
+type Extremum struct {
+	X     float64
+	Y     float64
+	IsMax bool
+}
+
+// AnalysisOptions 函数性质分析的参数，零值字段使用默认值
+type AnalysisOptions struct {
+	Samples   int     // 区间等分的采样数，默认1000
+	Tolerance float64 // 导数视为零及定位零点的精度，默认1e-9
+}
+
+// Analysis 函数在区间上的单调性、极值、拐点与最值，单调区间均为闭区间
+type Analysis struct {
+	Increasing  []sets.Interval
+	Decreasing  []sets.Interval
+	Extrema     []Extremum
+	Inflections []float64
+	GlobalMax   Extremum
+	GlobalMin   Extremum
+}
+
+var (
+	errStepSize    = "差分步长须为正数"
+	errBadInterval = "区间左端点须小于右端点"
+	errUndefinedAt = "函数在x=%v处没有定义"
+)
+
+// FromExpr 将单变量表达式转为实函数，求值出错处返回NaN
+func FromExpr(n expr.Node, v string) func(float64) float64 {
+	f := expr.Func(n, v)
+	return func(x float64) float64 {
+		y, err := f(x)
+		if err != nil {
+			return math.NaN()
+		}
+		return y
+	}
+}
+
+// CentralDifference 中心差商 [f(x+h) - f(x-h)]/2h，截断误差为O(h²)
+func CentralDifference(f func(float64) float64, x, h float64) float64 {
+	return (f(x+h) - f(x-h)) / (2 * h)
+}
+
+// SecondDifference 二阶中心差商 [f(x+h) - 2f(x) + f(x-h)]/h²
+func SecondDifference(f func(float64) float64, x, h float64) float64 {
+	return (f(x+h) - 2*f(x) + f(x-h)) / (h * h)
+}
+
+// richardson 对误差为O(h²)的差分公式逐次减半步长做理查森外推，返回结果与误差估计
+func richardson(d func(h float64) float64, h float64, levels int) (float64, float64) {
+	table := make([][]float64, levels)
+	var best float64
+	estimate := math.Inf(1)
+	for i := range table {
+		table[i] = make([]float64, i+1)
+		table[i][0] = d(h / math.Pow(2, float64(i)))
+		if i == 0 {
+			best = table[0][0]
+		}
+		factor := 1.0
+		for j := 1; j <= i; j++ {
+			factor *= 4
+			table[i][j] = table[i][j-1] + (table[i][j-1]-table[i-1][j-1])/(factor-1)
+		}
+		if i > 0 {
+			// 舍入误差开始占主导时外推值反而变差，保留误差最小的一次
+			diff := math.Abs(table[i][i] - table[i-1][i-1])
+			if diff < estimate {
+				best, estimate = table[i][i], diff
+			}
+		}
+	}
+	return best, estimate
+}
+
+// Richardson 中心差商配合理查森外推求f'(x)，返回导数值与误差估计
+func Richardson(f func(float64) float64, x, h float64, levels int) (float64, float64, error) {
+	if h <= 0 {
+		return 0, 0, errors.New(errStepSize)
+	}
+	d, e := richardson(func(h float64) float64 { return CentralDifference(f, x, h) }, h, max(levels, 2))
+	if math.IsNaN(d) {
+		return 0, 0, fmt.Errorf(errUndefinedAt, x)
+	}
+	return d, e, nil
+}
+
+// NumericDerivative 数值求导f'(x)
+func NumericDerivative(f func(float64) float64, x float64) (float64, error) {
+	d, _, err := Richardson(f, x, stepFor(x), 6)
+	return d, err
+}
+
+// NumericSecondDerivative 数值求二阶导f”(x)
+func NumericSecondDerivative(f func(float64) float64, x float64) (float64, error) {
+	d, _ := richardson(func(h float64) float64 { return SecondDifference(f, x, h) }, 10*stepFor(x), 4)
+	if math.IsNaN(d) {
+		return 0, fmt.Errorf(errUndefinedAt, x)
+	}
+	return d, nil
+}
+
+// stepFor 按x的量级选择初始步长
+func stepFor(x float64) float64 {
+	return 1e-2 * math.Max(1, math.Abs(x))
+}
+
+// Analyze 分析函数在[a, b]上的单调区间、极值点、拐点与最值
+// 先等距采样导数的符号，再在变号处用solver包定位导数零点，因此只在某一采样间隔内来回振荡的情况可能被漏判
+func Analyze(f func(float64) float64, a, b float64, opts AnalysisOptions) (Analysis, error) {
+	if a >= b {
+		return Analysis{}, errors.New(errBadInterval)
+	}
+	if opts.Samples <= 0 {
+		opts.Samples = 1000
+	}
+	if opts.Tolerance <= 0 {
+		opts.Tolerance = 1e-9
+	}
+	df := func(x float64) float64 {
+		d, _ := NumericDerivative(f, x)
+		return d
+	}
+	d2f := func(x float64) float64 {
+		d, _ := NumericSecondDerivative(f, x)
+		return d
+	}
+	xs := make([]float64, opts.Samples+1)
+	for i := range xs {
+		xs[i] = a + (b-a)*float64(i)/float64(opts.Samples)
+		if math.IsNaN(f(xs[i])) {
+			return Analysis{}, fmt.Errorf(errUndefinedAt, xs[i])
+		}
+	}
+	// 导数变号点即极值点，把区间切分为若干单调区间
+	criticals, before := signChanges(df, xs, opts.Tolerance)
+	var result Analysis
+	bounds := append(append([]float64{a}, criticals...), b)
+	for i := 0; i+1 < len(bounds); i++ {
+		left, right := bounds[i], bounds[i+1]
+		slope := averageSign(df, left, right, opts.Tolerance)
+		switch {
+		case slope > 0:
+			result.Increasing = appendInterval(result.Increasing, left, right)
+		case slope < 0:
+			result.Decreasing = appendInterval(result.Decreasing, left, right)
+		}
+	}
+	for i, x := range criticals {
+		// 导数由正变负为极大值，由负变正为极小值
+		result.Extrema = append(result.Extrema, Extremum{X: x, Y: f(x), IsMax: before[i] > 0})
+	}
+	result.Inflections, _ = signChanges(d2f, xs, opts.Tolerance)
+	candidates := append([]Extremum{{X: a, Y: f(a)}, {X: b, Y: f(b)}}, result.Extrema...)
+	result.GlobalMax, result.GlobalMin = candidates[0], candidates[0]
+	for _, c := range candidates[1:] {
+		if c.Y > result.GlobalMax.Y {
+			result.GlobalMax = c
+		}
+		if c.Y < result.GlobalMin.Y {
+			result.GlobalMin = c
+		}
+	}
+	result.GlobalMax.IsMax, result.GlobalMin.IsMax = true, false
+	return result, nil
+}
+
+// signChanges 在采样点之间寻找g的变号位置，并用布伦特方法精确定位，同时返回变号前的符号
+func signChanges(g func(float64) float64, xs []float64, tol float64) ([]float64, []int) {
+	var roots []float64
+	var before []int
+	prevX, prevSign := xs[0], sign(g(xs[0]), tol)
+	for _, x := range xs[1:] {
+		s := sign(g(x), tol)
+		if s == 0 {
+			// 恰好落在零点上时，等到符号恢复后再判断是否变号
+			continue
+		}
+		if prevSign != 0 && s != prevSign {
+			root, err := solver.Brent(g, prevX, x, solver.Options{Tolerance: tol})
+			if err != nil {
+				// 数值导数在极窄范围内抖动时退回区间中点
+				root = (prevX + x) / 2
+			}
+			roots = append(roots, root)
+			before = append(before, prevSign)
+		}
+		prevX, prevSign = x, s
+	}
+	return roots, before
+}
+
+// averageSign 以区间内若干点导数之和判断单调方向，避免端点处导数为零的干扰
+func averageSign(df func(float64) float64, left, right, tol float64) int {
+	var sum float64
+	for _, t := range []float64{0.25, 0.5, 0.75} {
+		sum += df(left + (right-left)*t)
+	}
+	return sign(sum, tol)
+}
+
+// appendInterval 追加区间，与上一个区间首尾相接时合并
+func appendInterval(intervals []sets.Interval, left, right float64) []sets.Interval {
+	if n := len(intervals); n > 0 && intervals[n-1].Right == left {
+		intervals[n-1].Right = right
+		return intervals
+	}
+	return append(intervals, sets.Closed(left, right))
+}
+
+// sign 带容差的符号函数
+func sign(x, tol float64) int {
+	switch {
+	case x > tol:
+		return 1
+	case x < -tol:
+		return -1
+	}
+	return 0
+}
@@ -9,3 +9,209 @@
 //"简单复合函数导数公式"
 
 package calculus
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"guts/maths/expr"
+)
+
+var (
+	errDerivOrder = "求导阶数须为正整数"
+	errNotDiff    = "无法对%s求导"
+)
+
+// Derivative 对变量v求符号导数，结果已化简
+// 覆盖基本初等函数的导数公式、四则运算法则与复合函数的链式法则
+func Derivative(f expr.Node, v string) (expr.Node, error) {
+	d, err := derive(f, v)
+	if err != nil {
+		return nil, err
+	}
+	return expr.Simplify(d), nil
+}
+
+// NthDerivative 对变量v求n阶符号导数
+func NthDerivative(f expr.Node, v string, n int) (expr.Node, error) {
+	if n < 1 {
+		return nil, errors.New(errDerivOrder)
+	}
+	var err error
+	for i := 0; i < n; i++ {
+		if f, err = Derivative(f, v); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// TangentLine 求曲线 y = f(x) 在 x = x0 处的切线 y = kx + b，返回斜率k与截距b
+func TangentLine(f expr.Node, v string, x0 float64) (float64, float64, error) {
+	df, err := Derivative(f, v)
+	if err != nil {
+		return 0, 0, err
+	}
+	vars := map[string]float64{v: x0}
+	y0, err := f.Eval(vars)
+	if err != nil {
+		return 0, 0, err
+	}
+	k, err := df.Eval(vars)
+	if err != nil {
+		return 0, 0, err
+	}
+	return k, y0 - k*x0, nil
+}
+
+// TangentLineExpr 以表达式形式给出切线方程右端 f'(x0)(x - x0) + f(x0)
+func TangentLineExpr(f expr.Node, v string, x0 float64) (expr.Node, error) {
+	k, b, err := TangentLine(f, v, x0)
+	if err != nil {
+		return nil, err
+	}
+	line := expr.Binary{Op: '+', Left: mul(num(k), expr.Variable{Name: v}), Right: num(b)}
+	return expr.Simplify(line), nil
+}
+
+// dependsOn 判断表达式是否含有变量v
+func dependsOn(n expr.Node, v string) bool {
+	return slices.Contains(expr.Variables(n), v)
+}
+
+// derive 逐节点应用求导法则，结果未化简
+func derive(n expr.Node, v string) (expr.Node, error) {
+	// 手工构造的Call可能参数个数不对，与求值时报告同样的错误
+	if c, ok := n.(expr.Call); ok {
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if !dependsOn(n, v) {
+		return num(0), nil
+	}
+	switch n := n.(type) {
+	case expr.Variable:
+		return num(1), nil
+	case expr.Unary:
+		d, err := derive(n.Operand, v)
+		if err != nil {
+			return nil, err
+		}
+		return expr.Unary{Operand: d}, nil
+	case expr.Binary:
+		return deriveBinary(n, v)
+	case expr.Call:
+		return deriveCall(n, v)
+	}
+	return nil, fmt.Errorf(errNotDiff, n)
+}
+
+// deriveBinary 四则运算法则与幂函数、指数函数求导
+func deriveBinary(n expr.Binary, v string) (expr.Node, error) {
+	u, w := n.Left, n.Right
+	du, err := derive(u, v)
+	if err != nil {
+		return nil, err
+	}
+	dw, err := derive(w, v)
+	if err != nil {
+		return nil, err
+	}
+	switch n.Op {
+	case '+', '-':
+		return expr.Binary{Op: n.Op, Left: du, Right: dw}, nil
+	case '*':
+		// (uw)' = u'w + uw'
+		return add(mul(du, w), mul(u, dw)), nil
+	case '/':
+		// (u/w)' = (u'w - uw')/w²
+		return div(sub(mul(du, w), mul(u, dw)), pow(w, num(2))), nil
+	case '^':
+		switch {
+		case !dependsOn(w, v):
+			// (uⁿ)' = n·uⁿ⁻¹·u'
+			return mul(mul(w, pow(u, sub(w, num(1)))), du), nil
+		case !dependsOn(u, v):
+			// (aʷ)' = aʷ·ln a·w'
+			return mul(mul(n, call("ln", u)), dw), nil
+		default:
+			// (uʷ)' = uʷ·(w'·ln u + w·u'/u)
+			return mul(n, add(mul(dw, call("ln", u)), div(mul(w, du), u))), nil
+		}
+	}
+	return nil, fmt.Errorf(errNotDiff, n)
+}
+
+// deriveCall 基本初等函数的导数公式配合链式法则
+func deriveCall(n expr.Call, v string) (expr.Node, error) {
+	if n.Name == "log" {
+		base, u := n.Args[0], n.Args[1]
+		if dependsOn(base, v) {
+			// 底数含变量时先换底：log_b(u) = ln u / ln b
+			return derive(div(call("ln", u), call("ln", base)), v)
+		}
+		// (log_a u)' = u'/(u·ln a)
+		du, err := derive(u, v)
+		if err != nil {
+			return nil, err
+		}
+		return mul(div(num(1), mul(u, call("ln", base))), du), nil
+	}
+	u := n.Args[0]
+	du, err := derive(u, v)
+	if err != nil {
+		return nil, err
+	}
+	var outer expr.Node
+	switch n.Name {
+	case "sin":
+		outer = call("cos", u)
+	case "cos":
+		outer = expr.Unary{Operand: call("sin", u)}
+	case "tan":
+		outer = div(num(1), pow(call("cos", u), num(2)))
+	case "ln":
+		outer = div(num(1), u)
+	case "lg":
+		outer = div(num(1), mul(u, call("ln", num(10))))
+	case "exp":
+		outer = n
+	case "sqrt":
+		outer = div(num(1), mul(num(2), n))
+	case "abs":
+		outer = div(u, n)
+	default:
+		return nil, fmt.Errorf(errNotDiff, n)
+	}
+	return mul(outer, du), nil
+}
+
+func num(v float64) expr.Node {
+	return expr.Number{Value: v}
+}
+
+func call(name string, arg expr.Node) expr.Node {
+	return expr.Call{Name: name, Args: []expr.Node{arg}}
+}
+
+func add(a, b expr.Node) expr.Node {
+	return expr.Binary{Op: '+', Left: a, Right: b}
+}
+
+func sub(a, b expr.Node) expr.Node {
+	return expr.Binary{Op: '-', Left: a, Right: b}
+}
+
+func mul(a, b expr.Node) expr.Node {
+	return expr.Binary{Op: '*', Left: a, Right: b}
+}
+
+func div(a, b expr.Node) expr.Node {
+	return expr.Binary{Op: '/', Left: a, Right: b}
+}
+
+func pow(a, b expr.Node) expr.Node {
+	return expr.Binary{Op: '^', Left: a, Right: b}
+}
@@ -10,3 +10,305 @@
 //"泰勒公式"
 
 package calculus
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"guts/maths/algebra"
+)
+
+// InequalityResult 不等式 Lesser ≤ Greater 两侧的取值、等号是否成立及成立条件
+type InequalityResult struct {
+	Statement string  // 不等式本身
+	Lesser    float64 // 较小的一侧
+	Greater   float64 // 较大的一侧
+	Equality  bool    // 当前取值下等号是否成立
+	Condition string  // 等号成立的条件
+}
+
+var (
+	errEmptyInput     = "不得传入空集"
+	errNonPositiveArg = "实参须全部为正数"
+	errLengthMismatch = "两组数据的个数须相同"
+	errBadWeights     = "权重须非负且不全为零"
+	errNotConvex      = "函数在数据范围内既不是凸函数也不是凹函数"
+	errBernoulliBase  = "伯努利不等式要求x ≥ -1"
+	errLogMeanArgs    = "对数平均数要求a、b为正数"
+)
+
+// almostEqual 判断两侧在相对误差内相等
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
+// allEqual 判断数据是否全部相等
+func allEqual(xs []float64) bool {
+	for _, x := range xs {
+		if !almostEqual(x, xs[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// AMGM 均值不等式：(a₁+…+aₙ)/n ≥ ⁿ√(a₁…aₙ)，当且仅当各数相等时取等号
+func AMGM(xs []float64) (InequalityResult, error) {
+	_, geometric, arithmetic, _, err := algebra.MeanInequalities(xs)
+	if err != nil {
+		return InequalityResult{}, err
+	}
+	return InequalityResult{
+		Statement: "(a₁+…+aₙ)/n ≥ ⁿ√(a₁…aₙ)",
+		Lesser:    geometric,
+		Greater:   arithmetic,
+		Equality:  allEqual(xs),
+		Condition: "a₁ = a₂ = … = aₙ",
+	}, nil
+}
+
+// AMGMMinSum 积为定值P时，n个正数之和的最小值 n·ⁿ√P，当且仅当各数均为ⁿ√P时取得
+func AMGMMinSum(product float64, n int) (float64, float64, error) {
+	if product <= 0 || n <= 0 {
+		return 0, 0, errors.New(errNonPositiveArg)
+	}
+	each := math.Pow(product, 1/float64(n))
+	return float64(n) * each, each, nil
+}
+
+// AMGMMaxProduct 和为定值S时，n个正数之积的最大值 (S/n)ⁿ，当且仅当各数均为S/n时取得
+func AMGMMaxProduct(sum float64, n int) (float64, float64, error) {
+	if sum <= 0 || n <= 0 {
+		return 0, 0, errors.New(errNonPositiveArg)
+	}
+	each := sum / float64(n)
+	return math.Pow(each, float64(n)), each, nil
+}
+
+// CauchySchwarz n维柯西不等式：(Σaᵢbᵢ)² ≤ (Σaᵢ²)(Σbᵢ²)，当且仅当两组数对应成比例时取等号
+func CauchySchwarz(a, b []float64) (InequalityResult, error) {
+	if len(a) == 0 {
+		return InequalityResult{}, errors.New(errEmptyInput)
+	}
+	if len(a) != len(b) {
+		return InequalityResult{}, errors.New(errLengthMismatch)
+	}
+	var dot, aa, bb float64
+	for i := range a {
+		dot += a[i] * b[i]
+		aa += a[i] * a[i]
+		bb += b[i] * b[i]
+	}
+	_, notProportional := algebra.CauchyEquality(a, b)
+	return InequalityResult{
+		Statement: "(Σaᵢbᵢ)² ≤ (Σaᵢ²)(Σbᵢ²)",
+		Lesser:    dot * dot,
+		Greater:   aa * bb,
+		Equality:  notProportional == nil,
+		Condition: "a₁/b₁ = a₂/b₂ = … = aₙ/bₙ（向量a与b共线）",
+	}, nil
+}
+
+// Rearrangement 排序不等式：同序和 ≥ 乱序和 ≥ 反序和，返回三者
+// 当且仅当某一组数全部相等时三者相等
+func Rearrangement(a, b []float64) (float64, float64, float64, error) {
+	if len(a) == 0 {
+		return 0, 0, 0, errors.New(errEmptyInput)
+	}
+	if len(a) != len(b) {
+		return 0, 0, 0, errors.New(errLengthMismatch)
+	}
+	sortedA := append([]float64{}, a...)
+	sortedB := append([]float64{}, b...)
+	sort.Float64s(sortedA)
+	sort.Float64s(sortedB)
+	var ordered, given, reversed float64
+	n := len(a)
+	for i := range a {
+		ordered += sortedA[i] * sortedB[i]
+		given += a[i] * b[i]
+		reversed += sortedA[i] * sortedB[n-1-i]
+	}
+	return ordered, given, reversed, nil
+}
+
+// Jensen 琴生不等式：f为凸函数时 f(Σwᵢxᵢ) ≤ Σwᵢf(xᵢ)，凹函数时反向
+// 凹凸性由数据范围内的数值二阶导判断，weights为nil时取等权
+func Jensen(f func(float64) float64, xs, weights []float64) (InequalityResult, error) {
+	w, err := normalizeWeights(xs, weights)
+	if err != nil {
+		return InequalityResult{}, err
+	}
+	lo, hi := xs[0], xs[0]
+	for _, x := range xs {
+		lo, hi = math.Min(lo, x), math.Max(hi, x)
+	}
+	convex, concave := true, true
+	if hi > lo {
+		for i := 0; i <= 100; i++ {
+			d2, err := NumericSecondDerivative(f, lo+(hi-lo)*float64(i)/100)
+			if err != nil {
+				return InequalityResult{}, err
+			}
+			convex = convex && d2 >= -1e-6
+			concave = concave && d2 <= 1e-6
+		}
+	}
+	if !convex && !concave {
+		return InequalityResult{}, errors.New(errNotConvex)
+	}
+	var mean, meanOfF float64
+	for i, x := range xs {
+		mean += w[i] * x
+		meanOfF += w[i] * f(x)
+	}
+	result := InequalityResult{
+		Statement: "f(Σwᵢxᵢ) ≤ Σwᵢf(xᵢ)（f为凸函数）",
+		Lesser:    f(mean),
+		Greater:   meanOfF,
+		Condition: "x₁ = x₂ = … = xₙ，或f在数据范围内为一次函数",
+	}
+	if !convex {
+		result.Statement = "f(Σwᵢxᵢ) ≥ Σwᵢf(xᵢ)（f为凹函数）"
+		result.Lesser, result.Greater = result.Greater, result.Lesser
+	}
+	result.Equality = almostEqual(result.Lesser, result.Greater)
+	return result, nil
+}
+
+// normalizeWeights 校验权重并归一化，weights为nil时取等权
+func normalizeWeights(xs, weights []float64) ([]float64, error) {
+	if len(xs) == 0 {
+		return nil, errors.New(errEmptyInput)
+	}
+	if weights == nil {
+		weights = make([]float64, len(xs))
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+	if len(weights) != len(xs) {
+		return nil, errors.New(errLengthMismatch)
+	}
+	var total float64
+	for _, w := range weights {
+		if w < 0 {
+			return nil, errors.New(errBadWeights)
+		}
+		total += w
+	}
+	if total == 0 {
+		return nil, errors.New(errBadWeights)
+	}
+	result := make([]float64, len(weights))
+	for i, w := range weights {
+		result[i] = w / total
+	}
+	return result, nil
+}
+
+// PowerMean 加权幂平均数 Mₚ = (Σwᵢxᵢᵖ)^(1/p)
+// p = 0 为几何平均数，p = ±Inf 为最大、最小值，p = -1、1、2 依次为调和、算术、平方平均数
+func PowerMean(xs, weights []float64, p float64) (float64, error) {
+	w, err := normalizeWeights(xs, weights)
+	if err != nil {
+		return 0, err
+	}
+	for _, x := range xs {
+		if x <= 0 {
+			return 0, errors.New(errNonPositiveArg)
+		}
+	}
+	switch {
+	case math.IsInf(p, 1):
+		return maxPositive(xs, w), nil
+	case math.IsInf(p, -1):
+		return -maxPositive(negate(xs), w), nil
+	case p == 0:
+		var logSum float64
+		for i, x := range xs {
+			logSum += w[i] * math.Log(x)
+		}
+		return math.Exp(logSum), nil
+	}
+	var sum float64
+	for i, x := range xs {
+		sum += w[i] * math.Pow(x, p)
+	}
+	return math.Pow(sum, 1/p), nil
+}
+
+// maxPositive 权重为正的数据中的最大值
+func maxPositive(xs, w []float64) float64 {
+	best := math.Inf(-1)
+	for i, x := range xs {
+		if w[i] > 0 {
+			best = math.Max(best, x)
+		}
+	}
+	return best
+}
+
+func negate(xs []float64) []float64 {
+	result := make([]float64, len(xs))
+	for i, x := range xs {
+		result[i] = -x
+	}
+	return result
+}
+
+// PowerMeanInequality 幂平均不等式：p < q 时 Mₚ ≤ M_q，当且仅当各数相等时取等号
+func PowerMeanInequality(xs, weights []float64, p, q float64) (InequalityResult, error) {
+	if p > q {
+		p, q = q, p
+	}
+	mp, err := PowerMean(xs, weights, p)
+	if err != nil {
+		return InequalityResult{}, err
+	}
+	mq, err := PowerMean(xs, weights, q)
+	if err != nil {
+		return InequalityResult{}, err
+	}
+	return InequalityResult{
+		Statement: "Mₚ ≤ M_q（p < q）",
+		Lesser:    mp,
+		Greater:   mq,
+		Equality:  almostEqual(mp, mq),
+		Condition: "权重为正的各数全部相等，或p = q",
+	}, nil
+}
+
+// Bernoulli 伯努利不等式：x ≥ -1时，r ≥ 1或r ≤ 0有 (1+x)ʳ ≥ 1+rx，0 ≤ r ≤ 1时反向
+func Bernoulli(x, r float64) (InequalityResult, error) {
+	if x < -1 {
+		return InequalityResult{}, errors.New(errBernoulliBase)
+	}
+	power, linear := math.Pow(1+x, r), 1+r*x
+	result := InequalityResult{
+		Statement: "(1+x)ʳ ≥ 1+rx（r ≥ 1或r ≤ 0）",
+		Lesser:    linear,
+		Greater:   power,
+		Condition: "x = 0，或r = 0、r = 1",
+	}
+	if r > 0 && r < 1 {
+		result.Statement = "(1+x)ʳ ≤ 1+rx（0 < r < 1）"
+		result.Lesser, result.Greater = power, linear
+	}
+	result.Equality = almostEqual(power, linear)
+	return result, nil
+}
+
+// LogarithmicMean 对数均值不等式：√(ab) ≤ (a-b)/(ln a - ln b) ≤ (a+b)/2，a = b时三者相等
+// 返回几何平均数、对数平均数与算术平均数
+func LogarithmicMean(a, b float64) (float64, float64, float64, error) {
+	if a <= 0 || b <= 0 {
+		return 0, 0, 0, errors.New(errLogMeanArgs)
+	}
+	geometric, arithmetic := math.Sqrt(a*b), (a+b)/2
+	if almostEqual(a, b) {
+		return geometric, a, arithmetic, nil
+	}
+	return geometric, (a - b) / (math.Log(a) - math.Log(b)), arithmetic, nil
+}
@@ -0,0 +1,283 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package calculus
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	errTolerance       = "精度要求须为正数"
+	errGaussOrder      = "高斯求积的节点数须为正整数"
+	errIntegralDiverge = "积分在允许的细分次数内未达到精度要求"
+	errBadBounds       = "积分上下限不得为NaN"
+	errUndefinedIn     = "被积函数在积分区间内存在没有定义的点"
+	errShellAxis       = "柱壳法要求积分区间位于y轴右侧，即a ≥ 0"
+)
+
+const (
+	maxIntegralDepth = 50   // 自适应积分的最大递归深度
+	maxRombergLevels = 25   // 龙贝格积分的最大层数，第k层需计算2ᵏ⁻¹个新节点
+	maxGaussSplits   = 4096 // 自适应高斯积分最多细分的次数，用完即视为不收敛
+)
+
+// AdaptiveSimpson 自适应辛普森积分，返回积分值与误差估计
+func AdaptiveSimpson(f func(float64) float64, a, b, tol float64) (float64, float64, error) {
+	if tol <= 0 {
+		return 0, 0, errors.New(errTolerance)
+	}
+	fa, fm, fb := f(a), f((a+b)/2), f(b)
+	whole := (b - a) * (fa + 4*fm + fb) / 6
+	value, estimate, ok := simpsonStep(f, a, b, fa, fm, fb, whole, tol, maxIntegralDepth)
+	if math.IsNaN(value) {
+		return 0, 0, errors.New(errUndefinedIn)
+	}
+	if !ok {
+		return value, estimate, errors.New(errIntegralDiverge)
+	}
+	return value, estimate, nil
+}
+
+// simpsonStep 比较整段与两半段的辛普森值，误差超限时继续对半细分
+func simpsonStep(f func(float64) float64, a, b, fa, fm, fb, whole, tol float64, depth int) (float64, float64, bool) {
+	m := (a + b) / 2
+	lm, rm := (a+m)/2, (m+b)/2
+	flm, frm := f(lm), f(rm)
+	left := (m - a) * (fa + 4*flm + fm) / 6
+	right := (b - m) * (fm + 4*frm + fb) / 6
+	diff := left + right - whole
+	if math.Abs(diff) <= 15*tol || depth <= 0 {
+		// 理查森修正项 diff/15 提升一阶精度
+		return left + right + diff/15, math.Abs(diff) / 15, depth > 0
+	}
+	lv, le, lok := simpsonStep(f, a, m, fa, flm, fm, left, tol/2, depth-1)
+	rv, re, rok := simpsonStep(f, m, b, fm, frm, fb, right, tol/2, depth-1)
+	return lv + rv, le + re, lok && rok
+}
+
+// legendreNodes 用牛顿迭代求n阶勒让德多项式的零点与对应的高斯求积权重
+func legendreNodes(n int) ([]float64, []float64) {
+	nodes := make([]float64, n)
+	weights := make([]float64, n)
+	for i := 0; i < (n+1)/2; i++ {
+		x := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(n) + 0.5))
+		var dp float64
+		for iter := 0; iter < 100; iter++ {
+			// 三项递推 (k+1)Pₖ₊₁ = (2k+1)xPₖ - kPₖ₋₁
+			p0, p1 := 1.0, x
+			for k := 1; k < n; k++ {
+				p0, p1 = p1, ((2*float64(k)+1)*x*p1-float64(k)*p0)/float64(k+1)
+			}
+			if n == 1 {
+				p0, p1 = 1, x
+			}
+			dp = float64(n) * (x*p1 - p0) / (x*x - 1)
+			dx := p1 / dp
+			x -= dx
+			if math.Abs(dx) < 1e-15 {
+				break
+			}
+		}
+		nodes[i], nodes[n-1-i] = x, -x
+		w := 2 / ((1 - x*x) * dp * dp)
+		weights[i], weights[n-1-i] = w, w
+	}
+	return nodes, weights
+}
+
+// gaussLegendre n点高斯–勒让德求积
+func gaussLegendre(f func(float64) float64, a, b float64, nodes, weights []float64) float64 {
+	half, mid := (b-a)/2, (a+b)/2
+	var sum float64
+	for i, x := range nodes {
+		sum += weights[i] * f(mid+half*x)
+	}
+	return half * sum
+}
+
+// GaussLegendre n点高斯–勒让德积分，对2n-1次以下多项式精确
+// 误差估计取n点与2n点结果之差
+func GaussLegendre(f func(float64) float64, a, b float64, n int) (float64, float64, error) {
+	if n < 1 {
+		return 0, 0, errors.New(errGaussOrder)
+	}
+	nodes, weights := legendreNodes(n)
+	value := gaussLegendre(f, a, b, nodes, weights)
+	nodes, weights = legendreNodes(2 * n)
+	refined := gaussLegendre(f, a, b, nodes, weights)
+	if math.IsNaN(value) || math.IsNaN(refined) {
+		return 0, 0, errors.New(errUndefinedIn)
+	}
+	return value, math.Abs(refined - value), nil
+}
+
+// Romberg 龙贝格积分：梯形公式逐次减半步长并做理查森外推，返回积分值与误差估计
+// maxLevels小于2时取20，超过maxRombergLevels时按maxRombergLevels计
+func Romberg(f func(float64) float64, a, b, tol float64, maxLevels int) (float64, float64, error) {
+	if tol <= 0 {
+		return 0, 0, errors.New(errTolerance)
+	}
+	if maxLevels < 2 {
+		maxLevels = 20
+	}
+	maxLevels = min(maxLevels, maxRombergLevels)
+	h := b - a
+	prev := []float64{h * (f(a) + f(b)) / 2}
+	for level := 1; level < maxLevels; level++ {
+		h /= 2
+		var sum float64
+		for k := 1; k < 1<<level; k += 2 {
+			sum += f(a + float64(k)*h)
+		}
+		row := make([]float64, level+1)
+		row[0] = prev[0]/2 + h*sum
+		factor := 1.0
+		for j := 1; j <= level; j++ {
+			factor *= 4
+			row[j] = row[j-1] + (row[j-1]-prev[j-1])/(factor-1)
+		}
+		if math.IsNaN(row[level]) {
+			return 0, 0, errors.New(errUndefinedIn)
+		}
+		estimate := math.Abs(row[level] - prev[level-1])
+		if estimate <= tol {
+			return row[level], estimate, nil
+		}
+		prev = row
+	}
+	return prev[len(prev)-1], math.Abs(prev[len(prev)-1] - prev[len(prev)-2]), errors.New(errIntegralDiverge)
+}
+
+// adaptiveGauss 自适应高斯积分，不在端点处取值，适合端点有奇性的被积函数
+// 奇点附近的子区间误差随宽度缩小得很慢，因此子区间不再对半分配精度，而是各自满足tol
+// 这样细分次数可能随深度指数增长，budget为剩余的细分次数，用完即返回失败
+func adaptiveGauss(f func(float64) float64, a, b, whole, tol float64, nodes, weights []float64, depth int, budget *int) (float64, float64, bool) {
+	m := (a + b) / 2
+	left := gaussLegendre(f, a, m, nodes, weights)
+	right := gaussLegendre(f, m, b, nodes, weights)
+	diff := math.Abs(left + right - whole)
+	if diff <= tol {
+		return left + right, diff, true
+	}
+	if depth <= 0 || *budget <= 0 {
+		return left + right, diff, false
+	}
+	*budget--
+	lv, le, ok := adaptiveGauss(f, a, m, left, tol, nodes, weights, depth-1, budget)
+	if !ok {
+		return lv + right, le, false
+	}
+	rv, re, ok := adaptiveGauss(f, m, b, right, tol, nodes, weights, depth-1, budget)
+	return lv + rv, le + re, ok
+}
+
+// Improper 反常积分：无穷限用变量代换化为有限区间，端点奇点由不取端点的自适应高斯积分处理
+//
+//	[a, +∞)  x = a + t/(1-t),   t∈[0, 1)
+//	(-∞, b]  x = b - (1-t)/t,   t∈(0, 1]
+//	(-∞, +∞) x = t/(1-t²),      t∈(-1, 1)
+func Improper(f func(float64) float64, a, b, tol float64) (float64, float64, error) {
+	if tol <= 0 {
+		return 0, 0, errors.New(errTolerance)
+	}
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return 0, 0, errors.New(errBadBounds)
+	}
+	if a == b {
+		// 包括上下限同为+∞或同为-∞的情形，区间为空
+		return 0, 0, nil
+	}
+	if a > b {
+		value, estimate, err := Improper(f, b, a, tol)
+		return -value, estimate, err
+	}
+	g, lo, hi := f, a, b
+	switch {
+	case math.IsInf(a, -1) && math.IsInf(b, 1):
+		g = func(t float64) float64 {
+			d := 1 - t*t
+			return f(t/d) * (1 + t*t) / (d * d)
+		}
+		lo, hi = -1, 1
+	case math.IsInf(b, 1):
+		g = func(t float64) float64 {
+			d := 1 - t
+			return f(a+t/d) / (d * d)
+		}
+		lo, hi = 0, 1
+	case math.IsInf(a, -1):
+		g = func(t float64) float64 {
+			return f(b-(1-t)/t) / (t * t)
+		}
+		lo, hi = 0, 1
+	}
+	nodes, weights := legendreNodes(10)
+	whole := gaussLegendre(g, lo, hi, nodes, weights)
+	budget := maxGaussSplits
+	value, estimate, ok := adaptiveGauss(g, lo, hi, whole, tol, nodes, weights, 2*maxIntegralDepth, &budget)
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, 0, errors.New(errIntegralDiverge)
+	}
+	if !ok {
+		return value, estimate, errors.New(errIntegralDiverge)
+	}
+	return value, estimate, nil
+}
+
+// AreaBetween 两曲线 y=f(x)、y=g(x) 在[a, b]之间所围面积 ∫|f-g|dx
+// 先定位两曲线的交点，再在各段上分别积分，避免绝对值的尖点拖慢收敛
+func AreaBetween(f, g func(float64) float64, a, b, tol float64) (float64, error) {
+	if a >= b {
+		return 0, errors.New(errBadInterval)
+	}
+	diff := func(x float64) float64 { return f(x) - g(x) }
+	xs := make([]float64, 1001)
+	for i := range xs {
+		xs[i] = a + (b-a)*float64(i)/1000
+	}
+	crossings, _ := signChanges(diff, xs, 0)
+	bounds := append(append([]float64{a}, crossings...), b)
+	var area float64
+	for i := 0; i+1 < len(bounds); i++ {
+		value, _, err := AdaptiveSimpson(diff, bounds[i], bounds[i+1], tol/float64(len(bounds)))
+		if err != nil {
+			return 0, err
+		}
+		area += math.Abs(value)
+	}
+	return area, nil
+}
+
+// VolumeOfRevolution 旋转体体积（圆盘法）：曲线 y=f(x) 绕x轴旋转，V = π∫f²dx
+func VolumeOfRevolution(f func(float64) float64, a, b, tol float64) (float64, error) {
+	value, _, err := AdaptiveSimpson(func(x float64) float64 {
+		y := f(x)
+		return y * y
+	}, a, b, tol/math.Pi)
+	return math.Pi * value, err
+}
+
+// WasherVolume 旋转体体积（垫圈法）：外曲线f与内曲线g之间的区域绕x轴旋转，V = π∫(f²-g²)dx
+func WasherVolume(f, g func(float64) float64, a, b, tol float64) (float64, error) {
+	value, _, err := AdaptiveSimpson(func(x float64) float64 {
+		outer, inner := f(x), g(x)
+		return math.Abs(outer*outer - inner*inner)
+	}, a, b, tol/math.Pi)
+	return math.Pi * value, err
+}
+
+// ShellVolume 旋转体体积（柱壳法）：曲线 y=f(x)（0≤a<b）绕y轴旋转，V = 2π∫x·f(x)dx
+func ShellVolume(f func(float64) float64, a, b, tol float64) (float64, error) {
+	if !(a >= 0) {
+		return 0, errors.New(errShellAxis)
+	}
+	value, _, err := AdaptiveSimpson(func(x float64) float64 {
+		return x * math.Abs(f(x))
+	}, a, b, tol/(2*math.Pi))
+	return 2 * math.Pi * value, err
+}
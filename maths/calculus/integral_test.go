@@ -0,0 +1,70 @@
+package calculus
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// withinTime 在限定时间内运行反常积分，超时视为失败
+func withinTime(t *testing.T, f func(float64) float64, a, b float64) (float64, error) {
+	t.Helper()
+	type result struct {
+		value float64
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, _, err := Improper(f, a, b, 1e-9)
+		done <- result{value, err}
+	}()
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Improper 在[%v, %v]上超时", a, b)
+		return 0, nil
+	}
+}
+
+func TestImproperDivergent(t *testing.T) {
+	inf := math.Inf(1)
+	cases := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+	}{
+		{"∫₁^∞ 1/x dx", func(x float64) float64 { return 1 / x }, 1, inf},
+		{"∫₀¹ 1/x dx", func(x float64) float64 { return 1 / x }, 0, 1},
+		{"∫₀^∞ sin(x)/x dx", func(x float64) float64 { return math.Sin(x) / x }, 0, inf},
+	}
+	for _, c := range cases {
+		if _, err := withinTime(t, c.f, c.a, c.b); err == nil || err.Error() != errIntegralDiverge {
+			t.Errorf("%s: err = %v, 应为%q", c.name, err, errIntegralDiverge)
+		}
+	}
+}
+
+func TestImproperConvergent(t *testing.T) {
+	inf := math.Inf(1)
+	cases := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		want float64
+	}{
+		{"∫₁^∞ 1/x² dx", func(x float64) float64 { return 1 / (x * x) }, 1, inf, 1},
+		{"∫₀¹ 1/√x dx", func(x float64) float64 { return 1 / math.Sqrt(x) }, 0, 1, 2},
+		{"∫_{-∞}^∞ e^(-x²) dx", func(x float64) float64 { return math.Exp(-x * x) }, -inf, inf, math.Sqrt(math.Pi)},
+		{"∫_{+∞}^{+∞} f dx", math.Exp, inf, inf, 0},
+	}
+	for _, c := range cases {
+		got, err := withinTime(t, c.f, c.a, c.b)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if math.Abs(got-c.want) > 1e-7 {
+			t.Errorf("%s = %v, 应为 %v", c.name, got, c.want)
+		}
+	}
+}
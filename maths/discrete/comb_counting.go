@@ -13,3 +13,265 @@
 //"分步乘法计数原理"
 
 package discrete
+
+import (
+	"errors"
+	"iter"
+	"math/big"
+)
+
+var (
+	errNegativeArgs = "n与k须为非负整数"
+	errKExceedsN    = "k不得大于n"
+	errCircularZero = "环排列至少需要选出一个元素"
+)
+
+// checkNK 校验 0 ≤ k ≤ n
+func checkNK(n, k int) error {
+	if n < 0 || k < 0 {
+		return errors.New(errNegativeArgs)
+	}
+	if k > n {
+		return errors.New(errKExceedsN)
+	}
+	return nil
+}
+
+// Factorial 阶乘 n!
+func Factorial(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, errors.New(errNegativeArgs)
+	}
+	return new(big.Int).MulRange(1, int64(n)), nil
+}
+
+// Permutation 排列数 A(n, k) = n!/(n-k)!
+func Permutation(n, k int) (*big.Int, error) {
+	if err := checkNK(n, k); err != nil {
+		return nil, err
+	}
+	if k == 0 {
+		return big.NewInt(1), nil
+	}
+	return new(big.Int).MulRange(int64(n-k+1), int64(n)), nil
+}
+
+// Combination 组合数 C(n, k) = n!/(k!(n-k)!)
+func Combination(n, k int) (*big.Int, error) {
+	if err := checkNK(n, k); err != nil {
+		return nil, err
+	}
+	return new(big.Int).Binomial(int64(n), int64(k)), nil
+}
+
+// MultisetPermutation 不尽相异元素的全排列数：(n₁+…+nₘ)!/(n₁!…nₘ!)
+func MultisetPermutation(counts ...int) (*big.Int, error) {
+	result := big.NewInt(1)
+	total := 0
+	for _, c := range counts {
+		if c < 0 {
+			return nil, errors.New(errNegativeArgs)
+		}
+		// 逐组相乘 C(total+c, c)，避免先算出巨大的阶乘
+		total += c
+		result.Mul(result, new(big.Int).Binomial(int64(total), int64(c)))
+	}
+	return result, nil
+}
+
+// CircularPermutation 环排列数：从n个不同元素中取k个排成一圈，A(n, k)/k
+func CircularPermutation(n, k int) (*big.Int, error) {
+	if err := checkNK(n, k); err != nil {
+		return nil, err
+	}
+	if k == 0 {
+		return nil, errors.New(errCircularZero)
+	}
+	a, _ := Permutation(n, k)
+	return a.Quo(a, big.NewInt(int64(k))), nil
+}
+
+// CombinationWithRepetition 可重复组合数：从n种元素中可重复地取k个，C(n+k-1, k)
+func CombinationWithRepetition(n, k int) (*big.Int, error) {
+	if n < 0 || k < 0 {
+		return nil, errors.New(errNegativeArgs)
+	}
+	if n == 0 {
+		if k == 0 {
+			return big.NewInt(1), nil
+		}
+		return big.NewInt(0), nil
+	}
+	return Combination(n+k-1, k)
+}
+
+// AdditionPrinciple 分类加法计数原理：各类方法数之和
+func AdditionPrinciple(counts ...*big.Int) *big.Int {
+	result := new(big.Int)
+	for _, c := range counts {
+		result.Add(result, c)
+	}
+	return result
+}
+
+// MultiplicationPrinciple 分步乘法计数原理：各步方法数之积
+func MultiplicationPrinciple(counts ...*big.Int) *big.Int {
+	result := big.NewInt(1)
+	for _, c := range counts {
+		result.Mul(result, c)
+	}
+	return result
+}
+
+// Permutations 按字典序惰性枚举从0…n-1中取k个的全部排列，参数无效时不产生任何结果
+func Permutations(n, k int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		if checkNK(n, k) != nil {
+			return
+		}
+		used := make([]bool, n)
+		current := make([]int, 0, k)
+		var walk func() bool
+		walk = func() bool {
+			if len(current) == k {
+				return yield(append([]int{}, current...))
+			}
+			for i := 0; i < n; i++ {
+				if used[i] {
+					continue
+				}
+				used[i] = true
+				current = append(current, i)
+				ok := walk()
+				current = current[:len(current)-1]
+				used[i] = false
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+		walk()
+	}
+}
+
+// Combinations 按字典序惰性枚举从0…n-1中取k个的全部组合（组内升序）
+func Combinations(n, k int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		if checkNK(n, k) != nil {
+			return
+		}
+		current := make([]int, k)
+		for i := range current {
+			current[i] = i
+		}
+		for {
+			if !yield(append([]int{}, current...)) {
+				return
+			}
+			// 从右往左找第一个还能增大的位置
+			i := k - 1
+			for i >= 0 && current[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			current[i]++
+			for j := i + 1; j < k; j++ {
+				current[j] = current[j-1] + 1
+			}
+		}
+	}
+}
+
+// CombinationsWithRepetition 按字典序惰性枚举从0…n-1中可重复地取k个的全部组合（组内不减）
+func CombinationsWithRepetition(n, k int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		if n < 0 || k < 0 || (n == 0 && k > 0) {
+			return
+		}
+		current := make([]int, k)
+		for {
+			if !yield(append([]int{}, current...)) {
+				return
+			}
+			i := k - 1
+			for i >= 0 && current[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			current[i]++
+			for j := i + 1; j < k; j++ {
+				current[j] = current[i]
+			}
+		}
+	}
+}
+
+// MultisetPermutations 按字典序惰性枚举不尽相异元素的全部排列
+// counts[i]为元素i的个数，每个结果只出现一次
+func MultisetPermutations(counts ...int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		var current []int
+		for value, c := range counts {
+			if c < 0 {
+				return
+			}
+			for j := 0; j < c; j++ {
+				current = append(current, value)
+			}
+		}
+		for {
+			if !yield(append([]int{}, current...)) {
+				return
+			}
+			if !nextPermutation(current) {
+				return
+			}
+		}
+	}
+}
+
+// nextPermutation 原地变为字典序的下一个排列，已是最后一个时返回false
+func nextPermutation(a []int) bool {
+	i := len(a) - 2
+	for i >= 0 && a[i] >= a[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(a) - 1
+	for a[j] <= a[i] {
+		j--
+	}
+	a[i], a[j] = a[j], a[i]
+	for l, r := i+1, len(a)-1; l < r; l, r = l+1, r-1 {
+		a[l], a[r] = a[r], a[l]
+	}
+	return true
+}
+
+// CircularPermutations 惰性枚举从0…n-1中取k个排成一圈的全部环排列
+// 旋转后相同的排列只保留一个：固定最小元素在首位
+func CircularPermutations(n, k int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		if k == 0 {
+			return
+		}
+		for p := range Permutations(n, k) {
+			minIndex := 0
+			for i, v := range p {
+				if v < p[minIndex] {
+					minIndex = i
+				}
+			}
+			if minIndex == 0 && !yield(p) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package discrete
+
+import (
+	"fmt"
+	"iter"
+	"math/big"
+	"slices"
+	"testing"
+)
+
+// collect 收集枚举结果并检查互不重复
+func collect(t *testing.T, seq iter.Seq[[]int]) [][]int {
+	t.Helper()
+	seen := make(map[string]bool)
+	var result [][]int
+	for item := range seq {
+		key := fmt.Sprint(item)
+		if seen[key] {
+			t.Fatalf("重复的枚举结果 %v", item)
+		}
+		seen[key] = true
+		result = append(result, slices.Clone(item))
+	}
+	return result
+}
+
+// bruteSequences 暴力枚举 {0…n-1} 上长度为k的全部序列（可重复）
+func bruteSequences(n, k int) [][]int {
+	result := [][]int{{}}
+	for range k {
+		var next [][]int
+		for _, s := range result {
+			for v := range n {
+				next = append(next, append(slices.Clone(s), v))
+			}
+		}
+		result = next
+	}
+	return result
+}
+
+func distinct(s []int) bool {
+	sorted := slices.Clone(s)
+	slices.Sort(sorted)
+	return len(slices.Compact(sorted)) == len(s)
+}
+
+func checkCount(t *testing.T, name string, got *big.Int, err error, want int) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if got.Cmp(big.NewInt(int64(want))) != 0 {
+		t.Errorf("%s = %v, 枚举得到 %d", name, got, want)
+	}
+}
+
+func TestPermutationAndCombinationCounts(t *testing.T) {
+	for n := 0; n <= 6; n++ {
+		for k := 0; k <= n; k++ {
+			var perms, combs, multi int
+			for _, s := range bruteSequences(n, k) {
+				if distinct(s) {
+					perms++
+					if slices.IsSorted(s) {
+						combs++
+					}
+				}
+				if slices.IsSorted(s) {
+					multi++
+				}
+			}
+			name := fmt.Sprintf("(%d, %d)", n, k)
+
+			got, err := Permutation(n, k)
+			checkCount(t, "Permutation"+name, got, err, perms)
+			enumerated := collect(t, Permutations(n, k))
+			checkCount(t, "Permutations"+name, got, err, len(enumerated))
+			for _, p := range enumerated {
+				if len(p) != k || !distinct(p) {
+					t.Errorf("Permutations%s 产生非法排列 %v", name, p)
+				}
+			}
+
+			got, err = Combination(n, k)
+			checkCount(t, "Combination"+name, got, err, combs)
+			enumerated = collect(t, Combinations(n, k))
+			checkCount(t, "Combinations"+name, got, err, len(enumerated))
+
+			got, err = CombinationWithRepetition(n, k)
+			checkCount(t, "CombinationWithRepetition"+name, got, err, multi)
+			enumerated = collect(t, CombinationsWithRepetition(n, k))
+			checkCount(t, "CombinationsWithRepetition"+name, got, err, len(enumerated))
+		}
+	}
+}
+
+func TestCircularPermutation(t *testing.T) {
+	for n := 1; n <= 6; n++ {
+		for k := 1; k <= n; k++ {
+			// 旋转后相同的排列归为一类，以字典序最小的旋转作为代表
+			classes := make(map[string]bool)
+			for _, s := range bruteSequences(n, k) {
+				if !distinct(s) {
+					continue
+				}
+				best := slices.Clone(s)
+				for r := 1; r < k; r++ {
+					rotated := append(slices.Clone(s[r:]), s[:r]...)
+					if slices.Compare(rotated, best) < 0 {
+						best = rotated
+					}
+				}
+				classes[fmt.Sprint(best)] = true
+			}
+			name := fmt.Sprintf("CircularPermutation(%d, %d)", n, k)
+			got, err := CircularPermutation(n, k)
+			checkCount(t, name, got, err, len(classes))
+			checkCount(t, name+" 枚举", got, err, len(collect(t, CircularPermutations(n, k))))
+		}
+	}
+}
+
+func TestMultisetPermutation(t *testing.T) {
+	for _, counts := range [][]int{{1}, {2, 1}, {2, 2}, {3, 1, 1}, {2, 2, 2}, {1, 1, 1, 1}} {
+		var items []int
+		for v, c := range counts {
+			for range c {
+				items = append(items, v)
+			}
+		}
+		// 对位置做全排列后按得到的序列去重
+		arrangements := make(map[string]bool)
+		for p := range Permutations(len(items), len(items)) {
+			s := make([]int, len(p))
+			for i, j := range p {
+				s[i] = items[j]
+			}
+			arrangements[fmt.Sprint(s)] = true
+		}
+		name := fmt.Sprintf("MultisetPermutation%v", counts)
+		got, err := MultisetPermutation(counts...)
+		checkCount(t, name, got, err, len(arrangements))
+		checkCount(t, name+" 枚举", got, err, len(collect(t, MultisetPermutations(counts...))))
+	}
+}
+
+func TestPrinciplesAndFactorial(t *testing.T) {
+	got, err := Factorial(10)
+	checkCount(t, "Factorial(10)", got, err, 3628800)
+	checkCount(t, "AdditionPrinciple", AdditionPrinciple(big.NewInt(3), big.NewInt(4)), nil, 7)
+	checkCount(t, "MultiplicationPrinciple", MultiplicationPrinciple(big.NewInt(3), big.NewInt(4)), nil, 12)
+	if _, err := Combination(3, 4); err == nil {
+		t.Error("Combination(3, 4) 应返回错误")
+	}
+	if _, err := Permutation(-1, 0); err == nil {
+		t.Error("Permutation(-1, 0) 应返回错误")
+	}
+}
@@ -0,0 +1,701 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package discrete
+
+import (
+	"container/heap"
+	"errors"
+	"iter"
+	"math"
+	"math/big"
+	"math/bits"
+	"slices"
+)
+
+var (
+	errVertexRange    = "顶点编号超出范围"
+	errNegativeOrder  = "顶点数不得为负"
+	errNegativeWeight = "Dijkstra算法要求边权非负"
+	errNegativeCycle  = "图中存在从起点可达的负权回路"
+	errNeedUndirected = "该操作仅适用于无向图"
+	errNeedDirected   = "该操作仅适用于有向图"
+	errDisconnected   = "图不连通"
+	errHasCycle       = "有向图存在回路，无法拓扑排序"
+	errNoEulerPath    = "图中不存在欧拉路径"
+	errNotPlanar      = "边数超过平面图上界，图不是平面图"
+	errGraphTooLarge  = "顶点数过多，着色计数不可行"
+)
+
+const maxColoringOrder = 16 // 着色计数对顶点子集做3ⁿ级别的枚举
+
+// Edge 图中的一条边，无向图中From与To仅表示添加时的顺序
+type Edge struct {
+	From, To int
+	Weight   float64
+}
+
+// Graph 以邻接表存储的图，顶点编号为 0…n-1，允许重边与自环
+type Graph struct {
+	directed bool
+	edges    []Edge
+	adj      [][]int // adj[u] 为与u关联（有向图中为从u出发）的边在edges中的下标
+}
+
+// NewGraph 创建含n个顶点、没有边的图
+func NewGraph(n int, directed bool) (*Graph, error) {
+	if n < 0 {
+		return nil, errors.New(errNegativeOrder)
+	}
+	return &Graph{directed: directed, adj: make([][]int, n)}, nil
+}
+
+// Directed 是否为有向图
+func (g *Graph) Directed() bool {
+	return g.directed
+}
+
+// Order 顶点数
+func (g *Graph) Order() int {
+	return len(g.adj)
+}
+
+// Size 边数
+func (g *Graph) Size() int {
+	return len(g.edges)
+}
+
+// Edges 全部边的副本
+func (g *Graph) Edges() []Edge {
+	return slices.Clone(g.edges)
+}
+
+func (g *Graph) checkVertex(vs ...int) error {
+	for _, v := range vs {
+		if v < 0 || v >= len(g.adj) {
+			return errors.New(errVertexRange)
+		}
+	}
+	return nil
+}
+
+// AddEdge 添加一条权为w的边，无权图可取 w = 1
+func (g *Graph) AddEdge(u, v int, w float64) error {
+	if err := g.checkVertex(u, v); err != nil {
+		return err
+	}
+	id := len(g.edges)
+	g.edges = append(g.edges, Edge{From: u, To: v, Weight: w})
+	g.adj[u] = append(g.adj[u], id)
+	if !g.directed && u != v {
+		g.adj[v] = append(g.adj[v], id)
+	}
+	return nil
+}
+
+// other 边id在u处的另一端点
+func (g *Graph) other(id, u int) int {
+	e := g.edges[id]
+	if e.From == u {
+		return e.To
+	}
+	return e.From
+}
+
+// Neighbors 与u相邻（有向图中为u的出边终点）的顶点，重边会重复出现
+func (g *Graph) Neighbors(u int) ([]int, error) {
+	if err := g.checkVertex(u); err != nil {
+		return nil, err
+	}
+	result := make([]int, 0, len(g.adj[u]))
+	for _, id := range g.adj[u] {
+		result = append(result, g.other(id, u))
+	}
+	return result, nil
+}
+
+// Degree 顶点的度，自环计2；有向图中为出度与入度之和
+func (g *Graph) Degree(u int) (int, error) {
+	if err := g.checkVertex(u); err != nil {
+		return 0, err
+	}
+	d := 0
+	for _, e := range g.edges {
+		if e.From == u {
+			d++
+		}
+		if e.To == u {
+			d++
+		}
+	}
+	return d, nil
+}
+
+// InDegree 有向图中顶点的入度
+func (g *Graph) InDegree(u int) (int, error) {
+	if !g.directed {
+		return 0, errors.New(errNeedDirected)
+	}
+	if err := g.checkVertex(u); err != nil {
+		return 0, err
+	}
+	d := 0
+	for _, e := range g.edges {
+		if e.To == u {
+			d++
+		}
+	}
+	return d, nil
+}
+
+// OutDegree 有向图中顶点的出度
+func (g *Graph) OutDegree(u int) (int, error) {
+	if !g.directed {
+		return 0, errors.New(errNeedDirected)
+	}
+	if err := g.checkVertex(u); err != nil {
+		return 0, err
+	}
+	return len(g.adj[u]), nil
+}
+
+// BFS 从start出发的广度优先遍历序列
+func (g *Graph) BFS(start int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if g.checkVertex(start) != nil {
+			return
+		}
+		visited := make([]bool, len(g.adj))
+		visited[start] = true
+		queue := []int{start}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			if !yield(u) {
+				return
+			}
+			for _, id := range g.adj[u] {
+				if v := g.other(id, u); !visited[v] {
+					visited[v] = true
+					queue = append(queue, v)
+				}
+			}
+		}
+	}
+}
+
+// DFS 从start出发的深度优先遍历序列（先序），邻点按加边顺序访问
+func (g *Graph) DFS(start int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if g.checkVertex(start) != nil {
+			return
+		}
+		visited := make([]bool, len(g.adj))
+		var walk func(u int) bool
+		walk = func(u int) bool {
+			visited[u] = true
+			if !yield(u) {
+				return false
+			}
+			for _, id := range g.adj[u] {
+				if v := g.other(id, u); !visited[v] && !walk(v) {
+					return false
+				}
+			}
+			return true
+		}
+		walk(start)
+	}
+}
+
+// Components 连通分量，有向图按弱连通计算，每个分量内顶点升序
+func (g *Graph) Components() [][]int {
+	parent := make([]int, len(g.adj))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(x int) int {
+		if parent[x] != x {
+			parent[x] = find(parent[x])
+		}
+		return parent[x]
+	}
+	for _, e := range g.edges {
+		parent[find(e.From)] = find(e.To)
+	}
+	index := make(map[int]int)
+	var result [][]int
+	for v := range g.adj {
+		r := find(v)
+		i, ok := index[r]
+		if !ok {
+			i = len(result)
+			index[r] = i
+			result = append(result, nil)
+		}
+		result[i] = append(result[i], v)
+	}
+	return result
+}
+
+// IsConnected 是否连通（有向图为弱连通），空图视为连通
+func (g *Graph) IsConnected() bool {
+	return len(g.Components()) <= 1
+}
+
+type distItem struct {
+	v    int
+	dist float64
+}
+
+type distHeap []distItem
+
+func (h distHeap) Len() int           { return len(h) }
+func (h distHeap) Less(i, j int) bool { return h[i].dist < h[j].dist }
+func (h distHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *distHeap) Push(x any)        { *h = append(*h, x.(distItem)) }
+func (h *distHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// Dijkstra 单源最短路，返回各点距离（不可达为+Inf）与最短路树中的前驱（无前驱为-1）
+func (g *Graph) Dijkstra(src int) ([]float64, []int, error) {
+	if err := g.checkVertex(src); err != nil {
+		return nil, nil, err
+	}
+	for _, e := range g.edges {
+		if e.Weight < 0 {
+			return nil, nil, errors.New(errNegativeWeight)
+		}
+	}
+	dist, prev := initSingleSource(len(g.adj), src)
+	h := &distHeap{{src, 0}}
+	for h.Len() > 0 {
+		item := heap.Pop(h).(distItem)
+		u := item.v
+		if item.dist > dist[u] {
+			continue
+		}
+		for _, id := range g.adj[u] {
+			v := g.other(id, u)
+			if d := dist[u] + g.edges[id].Weight; d < dist[v] {
+				dist[v], prev[v] = d, u
+				heap.Push(h, distItem{v, d})
+			}
+		}
+	}
+	return dist, prev, nil
+}
+
+// BellmanFord 允许负权边的单源最短路，存在从src可达的负权回路时返回错误
+// 无向图的负权边本身即构成负权回路
+func (g *Graph) BellmanFord(src int) ([]float64, []int, error) {
+	if err := g.checkVertex(src); err != nil {
+		return nil, nil, err
+	}
+	dist, prev := initSingleSource(len(g.adj), src)
+	relax := func() bool {
+		changed := false
+		for _, e := range g.edges {
+			pairs := [][2]int{{e.From, e.To}}
+			if !g.directed {
+				pairs = append(pairs, [2]int{e.To, e.From})
+			}
+			for _, p := range pairs {
+				if d := dist[p[0]] + e.Weight; !math.IsInf(dist[p[0]], 1) && d < dist[p[1]] {
+					dist[p[1]], prev[p[1]] = d, p[0]
+					changed = true
+				}
+			}
+		}
+		return changed
+	}
+	for i := 1; i < len(g.adj); i++ {
+		if !relax() {
+			return dist, prev, nil
+		}
+	}
+	if relax() {
+		return nil, nil, errors.New(errNegativeCycle)
+	}
+	return dist, prev, nil
+}
+
+func initSingleSource(n, src int) ([]float64, []int) {
+	dist := make([]float64, n)
+	prev := make([]int, n)
+	for i := range dist {
+		dist[i], prev[i] = math.Inf(1), -1
+	}
+	dist[src] = 0
+	return dist, prev
+}
+
+// PathTo 由前驱数组还原从src到target的路径，不可达时返回nil
+func PathTo(prev []int, src, target int) []int {
+	if target < 0 || target >= len(prev) {
+		return nil
+	}
+	var path []int
+	for v := target; v != -1; v = prev[v] {
+		path = append(path, v)
+		if len(path) > len(prev) {
+			return nil
+		}
+	}
+	if path[len(path)-1] != src {
+		return nil
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// MinimumSpanningTree Kruskal算法求无向连通图的最小生成树，返回树边与总权
+func (g *Graph) MinimumSpanningTree() ([]Edge, float64, error) {
+	if g.directed {
+		return nil, 0, errors.New(errNeedUndirected)
+	}
+	sorted := slices.Clone(g.edges)
+	slices.SortStableFunc(sorted, func(a, b Edge) int {
+		switch {
+		case a.Weight < b.Weight:
+			return -1
+		case a.Weight > b.Weight:
+			return 1
+		}
+		return 0
+	})
+	parent := make([]int, len(g.adj))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(x int) int {
+		if parent[x] != x {
+			parent[x] = find(parent[x])
+		}
+		return parent[x]
+	}
+	var tree []Edge
+	total := 0.0
+	for _, e := range sorted {
+		a, b := find(e.From), find(e.To)
+		if a == b {
+			continue
+		}
+		parent[a] = b
+		tree = append(tree, e)
+		total += e.Weight
+	}
+	if len(g.adj) > 0 && len(tree) != len(g.adj)-1 {
+		return nil, 0, errors.New(errDisconnected)
+	}
+	return tree, total, nil
+}
+
+// TopologicalSort Kahn算法求有向无环图的拓扑序，同时入度为0时取编号小者
+func (g *Graph) TopologicalSort() ([]int, error) {
+	if !g.directed {
+		return nil, errors.New(errNeedDirected)
+	}
+	indeg := make([]int, len(g.adj))
+	for _, e := range g.edges {
+		indeg[e.To]++
+	}
+	h := &distHeap{}
+	for v, d := range indeg {
+		if d == 0 {
+			heap.Push(h, distItem{v, float64(v)})
+		}
+	}
+	order := make([]int, 0, len(g.adj))
+	for h.Len() > 0 {
+		u := heap.Pop(h).(distItem).v
+		order = append(order, u)
+		for _, id := range g.adj[u] {
+			v := g.edges[id].To
+			if indeg[v]--; indeg[v] == 0 {
+				heap.Push(h, distItem{v, float64(v)})
+			}
+		}
+	}
+	if len(order) != len(g.adj) {
+		return nil, errors.New(errHasCycle)
+	}
+	return order, nil
+}
+
+// eulerStart 判定欧拉路径的存在性并给出起点，circuit表示是否为欧拉回路
+// 无向图：有边的顶点连通，奇度顶点为0个（回路）或2个（路径）
+// 有向图：弱连通，所有顶点出入度相等（回路），或恰有一点出度比入度多1、一点入度比出度多1（路径）
+func (g *Graph) eulerStart() (start int, circuit bool, ok bool) {
+	if len(g.edges) == 0 {
+		return 0, true, len(g.adj) > 0
+	}
+	root := -1
+	for _, comp := range g.Components() {
+		for _, v := range comp {
+			if d, _ := g.Degree(v); d > 0 {
+				if root != -1 && root != comp[0] {
+					return 0, false, false
+				}
+				root = comp[0]
+			}
+		}
+	}
+	start = g.edges[0].From
+	if !g.directed {
+		odd := 0
+		for v := range g.adj {
+			if d, _ := g.Degree(v); d%2 == 1 {
+				if odd == 0 {
+					start = v
+				}
+				odd++
+			}
+		}
+		return start, odd == 0, odd == 0 || odd == 2
+	}
+	plus, minus := 0, 0
+	for v := range g.adj {
+		in, _ := g.InDegree(v)
+		switch diff := len(g.adj[v]) - in; diff {
+		case 0:
+		case 1:
+			plus++
+			start = v
+		case -1:
+			minus++
+		default:
+			return 0, false, false
+		}
+	}
+	if plus == 0 && minus == 0 {
+		return start, true, true
+	}
+	return start, false, plus == 1 && minus == 1
+}
+
+// HasEulerPath 是否存在经过每条边恰好一次的路径（一笔画）
+func (g *Graph) HasEulerPath() bool {
+	_, _, ok := g.eulerStart()
+	return ok
+}
+
+// HasEulerCircuit 是否存在经过每条边恰好一次并回到起点的回路
+func (g *Graph) HasEulerCircuit() bool {
+	_, circuit, ok := g.eulerStart()
+	return ok && circuit
+}
+
+// EulerPath Hierholzer算法求一条欧拉路径（存在回路时为回路），返回依次经过的顶点
+func (g *Graph) EulerPath() ([]int, error) {
+	start, _, ok := g.eulerStart()
+	if !ok {
+		return nil, errors.New(errNoEulerPath)
+	}
+	used := make([]bool, len(g.edges))
+	next := make([]int, len(g.adj))
+	stack := []int{start}
+	var path []int
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		for next[u] < len(g.adj[u]) && used[g.adj[u][next[u]]] {
+			next[u]++
+		}
+		if next[u] == len(g.adj[u]) {
+			path = append(path, u)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		id := g.adj[u][next[u]]
+		used[id] = true
+		stack = append(stack, g.other(id, u))
+	}
+	slices.Reverse(path)
+	return path, nil
+}
+
+// Bipartition 判定二部图，返回各顶点所属的部（0或1）；有向图按无向处理
+func (g *Graph) Bipartition() ([]int, bool) {
+	side := make([]int, len(g.adj))
+	for i := range side {
+		side[i] = -1
+	}
+	neighbors := g.undirectedNeighbors()
+	for s := range g.adj {
+		if side[s] != -1 {
+			continue
+		}
+		side[s] = 0
+		queue := []int{s}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, v := range neighbors[u] {
+				if side[v] == -1 {
+					side[v] = 1 - side[u]
+					queue = append(queue, v)
+				} else if side[v] == side[u] {
+					return nil, false
+				}
+			}
+		}
+	}
+	return side, true
+}
+
+// IsBipartite 是否为二部图
+func (g *Graph) IsBipartite() bool {
+	_, ok := g.Bipartition()
+	return ok
+}
+
+func (g *Graph) undirectedNeighbors() [][]int {
+	neighbors := make([][]int, len(g.adj))
+	for _, e := range g.edges {
+		neighbors[e.From] = append(neighbors[e.From], e.To)
+		if e.From != e.To {
+			neighbors[e.To] = append(neighbors[e.To], e.From)
+		}
+	}
+	return neighbors
+}
+
+// independentPartitions 把顶点集划分为j个非空独立集的方法数 a_j（j = 0…n）
+// 色多项式 P(k) = Σ a_j·k(k-1)…(k-j+1)
+func (g *Graph) independentPartitions() ([]*big.Int, error) {
+	n := len(g.adj)
+	if n > maxColoringOrder {
+		return nil, errors.New(errGraphTooLarge)
+	}
+	conflict := make([]uint32, n)
+	for _, e := range g.edges {
+		conflict[e.From] |= 1 << e.To
+		conflict[e.To] |= 1 << e.From
+	}
+	full := uint32(1)<<n - 1
+	independent := make([]bool, full+1)
+	independent[0] = true
+	for mask := uint32(1); mask <= full; mask++ {
+		v := bits.TrailingZeros32(mask)
+		rest := mask &^ (1 << v)
+		independent[mask] = independent[rest] && conflict[v]&mask == 0
+	}
+	// ways[mask] 为把mask划分为若干独立集的方法数，按块数逐层推进
+	result := make([]*big.Int, n+1)
+	ways := make([]uint64, full+1)
+	ways[0] = 1
+	result[0] = new(big.Int).SetUint64(ways[full])
+	for j := 1; j <= n; j++ {
+		next := make([]uint64, full+1)
+		for mask := uint32(1); mask <= full; mask++ {
+			low := mask & -mask
+			rest := mask &^ low
+			// 枚举包含最低位顶点的独立块
+			for sub := rest; ; sub = (sub - 1) & rest {
+				block := sub | low
+				if independent[block] {
+					next[mask] += ways[mask&^block]
+				}
+				if sub == 0 {
+					break
+				}
+			}
+		}
+		ways = next
+		result[j] = new(big.Int).SetUint64(ways[full])
+	}
+	return result, nil
+}
+
+// ColoringCount 用k种颜色给顶点着色、相邻顶点异色的方法数
+// 地图染色问题可把区域作为顶点、相邻区域间连边后求解
+func (g *Graph) ColoringCount(k int) (*big.Int, error) {
+	if k < 0 {
+		return nil, errors.New(errNegativeArgs)
+	}
+	a, err := g.independentPartitions()
+	if err != nil {
+		return nil, err
+	}
+	total := new(big.Int)
+	falling := big.NewInt(1)
+	for j := 0; j < len(a); j++ {
+		total.Add(total, new(big.Int).Mul(a[j], falling))
+		falling.Mul(falling, big.NewInt(int64(k-j)))
+	}
+	return total, nil
+}
+
+// ChromaticPolynomial 色多项式的系数，按升幂排列
+func (g *Graph) ChromaticPolynomial() ([]*big.Int, error) {
+	a, err := g.independentPartitions()
+	if err != nil {
+		return nil, err
+	}
+	coeffs := make([]*big.Int, len(a))
+	for i := range coeffs {
+		coeffs[i] = new(big.Int)
+	}
+	// falling 为 k(k-1)…(k-j+1) 的升幂系数
+	falling := []*big.Int{big.NewInt(1)}
+	for j := 0; j < len(a); j++ {
+		for i, c := range falling {
+			coeffs[i].Add(coeffs[i], new(big.Int).Mul(a[j], c))
+		}
+		next := make([]*big.Int, len(falling)+1)
+		next[0] = new(big.Int)
+		for i := range falling {
+			next[i+1] = new(big.Int).Set(falling[i])
+		}
+		for i, c := range falling {
+			next[i].Sub(next[i], new(big.Int).Mul(big.NewInt(int64(j)), c))
+		}
+		falling = next
+	}
+	return coeffs, nil
+}
+
+// ChromaticNumber 色数：使相邻顶点异色所需的最少颜色数
+func (g *Graph) ChromaticNumber() (int, error) {
+	a, err := g.independentPartitions()
+	if err != nil {
+		return 0, err
+	}
+	for j, v := range a {
+		if v.Sign() > 0 {
+			return j, nil
+		}
+	}
+	return 0, nil
+}
+
+// SatisfiesPlanarBound 平面图的必要条件：简单图 V ≥ 3 时 E ≤ 3V - 6，二部图更有 E ≤ 2V - 4
+// 不满足则一定不是平面图（如K₅、K₃,₃），满足时不保证是平面图
+func (g *Graph) SatisfiesPlanarBound() bool {
+	v, e := len(g.adj), len(g.edges)
+	if v < 3 {
+		return true
+	}
+	if g.IsBipartite() {
+		return e <= 2*v-4
+	}
+	return e <= 3*v-6
+}
+
+// PlanarFaces 按欧拉公式计算平面图的面数（含外部面）
+// 连通时 V - E + F = 2（与geometry.EulerCharacteristic一致），c个连通分量时 V - E + F = 1 + c
+func (g *Graph) PlanarFaces() (int, error) {
+	if !g.SatisfiesPlanarBound() {
+		return 0, errors.New(errNotPlanar)
+	}
+	return len(g.edges) - len(g.adj) + 1 + len(g.Components()), nil
+}
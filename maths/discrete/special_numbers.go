@@ -0,0 +1,248 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package discrete
+
+import (
+	"errors"
+	"iter"
+	"math/big"
+)
+
+var (
+	errTooManySets = "参与容斥的集合个数不得超过30"
+)
+
+const maxInclusionSets = 30 // 容斥原理枚举2ᵐ个子集，m过大时不可行
+
+// StirlingFirst 无符号第一类斯特林数 [n, k]：n个元素排成k个非空圆排列的方法数
+// 递推 [n, k] = [n-1, k-1] + (n-1)[n-1, k]
+func StirlingFirst(n, k int) (*big.Int, error) {
+	if err := checkNK(n, k); err != nil {
+		return nil, err
+	}
+	row := []*big.Int{big.NewInt(1)}
+	for i := 1; i <= n; i++ {
+		next := make([]*big.Int, i+1)
+		next[0] = new(big.Int)
+		for j := 1; j <= i; j++ {
+			next[j] = new(big.Int).Set(row[j-1])
+			if j < i {
+				next[j].Add(next[j], new(big.Int).Mul(big.NewInt(int64(i-1)), row[j]))
+			}
+		}
+		row = next
+	}
+	return row[k], nil
+}
+
+// StirlingSecond 第二类斯特林数 {n, k}：n个不同元素划分为k个非空集合的方法数
+// 递推 {n, k} = {n-1, k-1} + k{n-1, k}
+func StirlingSecond(n, k int) (*big.Int, error) {
+	if err := checkNK(n, k); err != nil {
+		return nil, err
+	}
+	row := []*big.Int{big.NewInt(1)}
+	for i := 1; i <= n; i++ {
+		next := make([]*big.Int, i+1)
+		next[0] = new(big.Int)
+		for j := 1; j <= i; j++ {
+			next[j] = new(big.Int).Set(row[j-1])
+			if j < i {
+				next[j].Add(next[j], new(big.Int).Mul(big.NewInt(int64(j)), row[j]))
+			}
+		}
+		row = next
+	}
+	return row[k], nil
+}
+
+// Bell 贝尔数Bₙ：n个不同元素划分为若干非空集合的方法总数，用贝尔三角形计算
+func Bell(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, errors.New(errNegativeArgs)
+	}
+	row := []*big.Int{big.NewInt(1)}
+	for i := 0; i < n; i++ {
+		next := []*big.Int{new(big.Int).Set(row[len(row)-1])}
+		for _, v := range row {
+			next = append(next, new(big.Int).Add(next[len(next)-1], v))
+		}
+		row = next
+	}
+	return row[0], nil
+}
+
+// Catalan 卡特兰数 Cₙ = C(2n, n)/(n+1)：n对括号的合法序列数、凸n+2边形的三角剖分数等
+func Catalan(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, errors.New(errNegativeArgs)
+	}
+	c := new(big.Int).Binomial(int64(2*n), int64(n))
+	return c.Quo(c, big.NewInt(int64(n+1))), nil
+}
+
+// Derangement 全错位排列数 Dₙ：递推 Dₙ = (n-1)(Dₙ₋₁ + Dₙ₋₂)，D₀ = 1，D₁ = 0
+func Derangement(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, errors.New(errNegativeArgs)
+	}
+	prev, cur := big.NewInt(1), big.NewInt(0)
+	if n == 0 {
+		return prev, nil
+	}
+	for i := 2; i <= n; i++ {
+		next := new(big.Int).Add(prev, cur)
+		next.Mul(next, big.NewInt(int64(i-1)))
+		prev, cur = cur, next
+	}
+	return cur, nil
+}
+
+// PartialDerangement 恰有k个元素在原位的排列数 C(n, k)·Dₙ₋ₖ
+func PartialDerangement(n, k int) (*big.Int, error) {
+	c, err := Combination(n, k)
+	if err != nil {
+		return nil, err
+	}
+	d, _ := Derangement(n - k)
+	return c.Mul(c, d), nil
+}
+
+// PartitionCount 整数拆分数p(n)：把n写成若干正整数之和（不计顺序）的方法数
+// 欧拉五边形数定理 p(n) = Σ(-1)^(k+1)[p(n - k(3k-1)/2) + p(n - k(3k+1)/2)]
+func PartitionCount(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, errors.New(errNegativeArgs)
+	}
+	p := make([]*big.Int, n+1)
+	p[0] = big.NewInt(1)
+	for i := 1; i <= n; i++ {
+		p[i] = new(big.Int)
+		for k := 1; ; k++ {
+			g1 := k * (3*k - 1) / 2
+			if g1 > i {
+				break
+			}
+			g2 := k * (3*k + 1) / 2
+			term := new(big.Int).Set(p[i-g1])
+			if g2 <= i {
+				term.Add(term, p[i-g2])
+			}
+			if k%2 == 1 {
+				p[i].Add(p[i], term)
+			} else {
+				p[i].Sub(p[i], term)
+			}
+		}
+	}
+	return p[n], nil
+}
+
+// PartitionCountInto 把n拆分为恰好k个正整数之和的方法数
+// 递推 p(n, k) = p(n-1, k-1) + p(n-k, k)
+func PartitionCountInto(n, k int) (*big.Int, error) {
+	if n < 0 || k < 0 {
+		return nil, errors.New(errNegativeArgs)
+	}
+	table := make([][]*big.Int, n+1)
+	for i := range table {
+		table[i] = make([]*big.Int, k+1)
+		for j := range table[i] {
+			table[i][j] = new(big.Int)
+		}
+	}
+	table[0][0].SetInt64(1)
+	for i := 1; i <= n; i++ {
+		for j := 1; j <= k && j <= i; j++ {
+			table[i][j].Add(table[i-1][j-1], table[i-j][j])
+		}
+	}
+	return table[n][k], nil
+}
+
+// Partitions 惰性枚举n的全部拆分，每个拆分的各部分按降序排列，拆分之间按字典序降序
+func Partitions(n int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		if n < 0 {
+			return
+		}
+		current := make([]int, 0, n)
+		var walk func(remaining, largest int) bool
+		walk = func(remaining, largest int) bool {
+			if remaining == 0 {
+				return yield(append([]int{}, current...))
+			}
+			for part := min(remaining, largest); part >= 1; part-- {
+				current = append(current, part)
+				ok := walk(remaining-part, part)
+				current = current[:len(current)-1]
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+		walk(n, n)
+	}
+}
+
+// UnionSize 容斥原理：|A₁∪…∪Aₘ| = Σ(-1)^(|S|+1)|∩ᵢ∈S Aᵢ|
+// intersection给出下标集合S对应的交集元素个数，适用于全集太大无法逐个枚举的情形
+func UnionSize(m int, intersection func(indices []int) *big.Int) (*big.Int, error) {
+	if m < 0 {
+		return nil, errors.New(errNegativeArgs)
+	}
+	if m > maxInclusionSets {
+		return nil, errors.New(errTooManySets)
+	}
+	total := new(big.Int)
+	for mask := 1; mask < 1<<m; mask++ {
+		var indices []int
+		for i := 0; i < m; i++ {
+			if mask&(1<<i) != 0 {
+				indices = append(indices, i)
+			}
+		}
+		size := intersection(indices)
+		if len(indices)%2 == 1 {
+			total.Add(total, size)
+		} else {
+			total.Sub(total, size)
+		}
+	}
+	return total, nil
+}
+
+// CountUnion 容斥原理：全集中至少满足一个谓词的元素个数
+// 各交集的大小通过逐个检验全集元素得到
+func CountUnion[T any](universe []T, predicates ...func(T) bool) (*big.Int, error) {
+	return UnionSize(len(predicates), func(indices []int) *big.Int {
+		count := int64(0)
+		for _, x := range universe {
+			all := true
+			for _, i := range indices {
+				if !predicates[i](x) {
+					all = false
+					break
+				}
+			}
+			if all {
+				count++
+			}
+		}
+		return big.NewInt(count)
+	})
+}
+
+// CountNone 容斥原理的补集形式：全集中一个谓词都不满足的元素个数
+func CountNone[T any](universe []T, predicates ...func(T) bool) (*big.Int, error) {
+	union, err := CountUnion(universe, predicates...)
+	if err != nil {
+		return nil, err
+	}
+	return union.Sub(big.NewInt(int64(len(universe))), union), nil
+}
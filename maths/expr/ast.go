@@ -0,0 +1,317 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"guts/maths/algebra"
+	"guts/maths/geometry"
+)
+
+// Node 表达式语法树的节点
+type Node interface {
+	// Eval 代入变量的取值求值，定义域错误沿用algebra与geometry包的错误
+	Eval(vars map[string]float64) (float64, error)
+	// String 输出纯文本形式，如 2*sin(x)^2 + log(2, x)
+	String() string
+	// LaTeX 输出LaTeX形式
+	LaTeX() string
+}
+
+// Number 数值常量
+type Number struct {
+	Value float64
+}
+
+// Variable 变量，pi与e未赋值时按数学常数处理
+type Variable struct {
+	Name string
+}
+
+// Unary 一元负号
+type Unary struct {
+	Operand Node
+}
+
+// Binary 二元运算，Op取 + - * / ^ 之一
+type Binary struct {
+	Op    byte
+	Left  Node
+	Right Node
+}
+
+// Call 函数调用，如 sin(x)、log(2, x)
+type Call struct {
+	Name string
+	Args []Node
+}
+
+var (
+	errUnbound      = "变量%s未赋值"
+	errDivideZero   = "除数不得为零"
+	errPowUndefined = "幂运算在此处没有定义"
+	errSqrtNegative = "负数不能开平方"
+	errUnknownFunc  = "未知函数%s"
+	errArity        = "函数%s需要%d个参数"
+	errUnknownOp    = "未知运算符%c"
+)
+
+// constants 未赋值时可直接使用的数学常数
+var constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+// function 内置函数的参数个数与求值方式
+type function struct {
+	arity int
+	eval  func(args []float64) (float64, error)
+}
+
+var functions = map[string]function{
+	"sin": {1, func(a []float64) (float64, error) { return geometry.Sin(a[0]), nil }},
+	"cos": {1, func(a []float64) (float64, error) { return geometry.Cos(a[0]), nil }},
+	"tan": {1, func(a []float64) (float64, error) { return geometry.Tan(a[0]) }},
+	"log": {2, func(a []float64) (float64, error) { return algebra.Log(a[0], a[1]) }},
+	"ln":  {1, func(a []float64) (float64, error) { return algebra.Log(math.E, a[0]) }},
+	"lg":  {1, func(a []float64) (float64, error) { return algebra.Log(10, a[0]) }},
+	"exp": {1, func(a []float64) (float64, error) { return math.Exp(a[0]), nil }},
+	"sqrt": {1, func(a []float64) (float64, error) {
+		if a[0] < 0 {
+			return 0, errors.New(errSqrtNegative)
+		}
+		return math.Sqrt(a[0]), nil
+	}},
+	"abs": {1, func(a []float64) (float64, error) { return math.Abs(a[0]), nil }},
+}
+
+// IsFunction 判断name是否为内置函数
+func IsFunction(name string) bool {
+	_, ok := functions[name]
+	return ok
+}
+
+// Eval 数值常量求值
+func (n Number) Eval(map[string]float64) (float64, error) {
+	return n.Value, nil
+}
+
+// Eval 变量求值
+func (v Variable) Eval(vars map[string]float64) (float64, error) {
+	if value, ok := vars[v.Name]; ok {
+		return value, nil
+	}
+	if value, ok := constants[v.Name]; ok {
+		return value, nil
+	}
+	return 0, fmt.Errorf(errUnbound, v.Name)
+}
+
+// Eval 一元负号求值
+func (u Unary) Eval(vars map[string]float64) (float64, error) {
+	value, err := u.Operand.Eval(vars)
+	return -value, err
+}
+
+// Eval 二元运算求值
+func (b Binary) Eval(vars map[string]float64) (float64, error) {
+	left, err := b.Left.Eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	right, err := b.Right.Eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	switch b.Op {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	case '/':
+		if math.Abs(right) < 1e-15 {
+			return 0, errors.New(errDivideZero)
+		}
+		return left / right, nil
+	case '^':
+		result := math.Pow(left, right)
+		if math.IsNaN(result) || math.IsInf(result, 0) {
+			return 0, errors.New(errPowUndefined)
+		}
+		return result, nil
+	}
+	return 0, fmt.Errorf(errUnknownOp, b.Op)
+}
+
+// Validate 检查函数名是否为内置函数、参数个数是否与之相符
+func (c Call) Validate() error {
+	fn, ok := functions[c.Name]
+	if !ok {
+		return fmt.Errorf(errUnknownFunc, c.Name)
+	}
+	if len(c.Args) != fn.arity {
+		return fmt.Errorf(errArity, c.Name, fn.arity)
+	}
+	return nil
+}
+
+// Eval 函数调用求值
+func (c Call) Eval(vars map[string]float64) (float64, error) {
+	if err := c.Validate(); err != nil {
+		return 0, err
+	}
+	fn := functions[c.Name]
+	args := make([]float64, len(c.Args))
+	for i, arg := range c.Args {
+		value, err := arg.Eval(vars)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = value
+	}
+	return fn.eval(args)
+}
+
+// Variables 列出表达式中出现的变量名（不含未赋值的常数），按字典序排列
+func Variables(n Node) []string {
+	seen := map[string]bool{}
+	var walk func(Node)
+	walk = func(n Node) {
+		switch n := n.(type) {
+		case Variable:
+			if _, ok := constants[n.Name]; !ok {
+				seen[n.Name] = true
+			}
+		case Unary:
+			walk(n.Operand)
+		case Binary:
+			walk(n.Left)
+			walk(n.Right)
+		case Call:
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		}
+	}
+	walk(n)
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Func 将单变量表达式转为函数，便于数值计算
+func Func(n Node, name string) func(x float64) (float64, error) {
+	return func(x float64) (float64, error) {
+		return n.Eval(map[string]float64{name: x})
+	}
+}
+
+// 运算优先级，数值越大结合越紧
+const (
+	precSum = iota + 1
+	precProduct
+	precUnary
+	precPower
+	precAtom
+)
+
+// precedence 节点在输出时的优先级
+func precedence(n Node) int {
+	switch n := n.(type) {
+	case Number:
+		if n.Value < 0 {
+			return precUnary
+		}
+	case Unary:
+		return precUnary
+	case Binary:
+		switch n.Op {
+		case '+', '-':
+			return precSum
+		case '*', '/':
+			return precProduct
+		case '^':
+			return precPower
+		}
+	}
+	return precAtom
+}
+
+// formatNumber 以最短形式输出浮点数，绝对值过大或过小时用科学计数法
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// String 数值常量的文本形式，极大极小的数用科学计数法，Parse可以重新解析
+func (n Number) String() string {
+	return formatNumber(n.Value)
+}
+
+// String 变量的文本形式
+func (v Variable) String() string {
+	return v.Name
+}
+
+// String 一元负号的文本形式
+func (u Unary) String() string {
+	return "-" + wrap(u.Operand, precedence(u.Operand) < precUnary, Node.String)
+}
+
+// String 二元运算的文本形式
+func (b Binary) String() string {
+	left, right := childParens(b)
+	l := wrap(b.Left, left, Node.String)
+	r := wrap(b.Right, right, Node.String)
+	switch b.Op {
+	case '+', '-':
+		return l + " " + string(b.Op) + " " + r
+	}
+	return l + string(b.Op) + r
+}
+
+// String 函数调用的文本形式
+func (c Call) String() string {
+	s := c.Name + "("
+	for i, arg := range c.Args {
+		if i > 0 {
+			s += ", "
+		}
+		s += arg.String()
+	}
+	return s + ")"
+}
+
+// childParens 判断二元运算的左右子节点是否需要加括号
+func childParens(b Binary) (bool, bool) {
+	p := precedence(b)
+	lp, rp := precedence(b.Left), precedence(b.Right)
+	if b.Op == '^' {
+		// 乘方右结合
+		return lp <= p, rp < p
+	}
+	// 右侧的负数或同级的减、除需要括号，如 x - (-2)、a/(b*c)
+	right := rp < p || rp == precUnary || (rp == p && (b.Op == '-' || b.Op == '/'))
+	return lp < p, right
+}
+
+// wrap 按需给子表达式加括号
+func wrap(n Node, parens bool, format func(Node) string) string {
+	if parens {
+		return "(" + format(n) + ")"
+	}
+	return format(n)
+}
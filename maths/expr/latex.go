@@ -0,0 +1,104 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package expr
+
+import "strings"
+
+// latexFunctions 可直接使用LaTeX命令的函数
+var latexFunctions = map[string]string{
+	"sin": `\sin`,
+	"cos": `\cos`,
+	"tan": `\tan`,
+	"ln":  `\ln`,
+	"lg":  `\lg`,
+}
+
+// LaTeX 数值常量的LaTeX形式
+func (n Number) LaTeX() string {
+	s := formatNumber(n.Value)
+	if i := strings.IndexAny(s, "e"); i >= 0 {
+		return s[:i] + `\times 10^{` + strings.TrimPrefix(s[i+1:], "+") + "}"
+	}
+	return s
+}
+
+// LaTeX 变量的LaTeX形式
+func (v Variable) LaTeX() string {
+	if v.Name == "pi" {
+		return `\pi`
+	}
+	return v.Name
+}
+
+// LaTeX 一元负号的LaTeX形式
+func (u Unary) LaTeX() string {
+	return "-" + latexWrap(u.Operand, precedence(u.Operand) < precUnary)
+}
+
+// LaTeX 二元运算的LaTeX形式
+func (b Binary) LaTeX() string {
+	switch b.Op {
+	case '/':
+		return `\frac{` + b.Left.LaTeX() + "}{" + b.Right.LaTeX() + "}"
+	case '^':
+		left, _ := childParens(b)
+		return "{" + latexWrap(b.Left, left) + "}^{" + b.Right.LaTeX() + "}"
+	}
+	left, right := childParens(b)
+	l := latexWrap(b.Left, left)
+	r := latexWrap(b.Right, right)
+	switch b.Op {
+	case '*':
+		// 数字与字母相乘时省略乘号，如 2x、3\sin x
+		if _, ok := b.Left.(Number); ok && !right && startsWithLetter(b.Right) {
+			return l + r
+		}
+		return l + ` \cdot ` + r
+	}
+	return l + " " + string(b.Op) + " " + r
+}
+
+// LaTeX 函数调用的LaTeX形式
+func (c Call) LaTeX() string {
+	args := make([]string, len(c.Args))
+	for i, arg := range c.Args {
+		args[i] = arg.LaTeX()
+	}
+	if cmd, ok := latexFunctions[c.Name]; ok && len(args) == 1 {
+		return cmd + `\left(` + args[0] + `\right)`
+	}
+	switch {
+	case c.Name == "log" && len(args) == 2:
+		return `\log_{` + args[0] + `}\left(` + args[1] + `\right)`
+	case c.Name == "sqrt" && len(args) == 1:
+		return `\sqrt{` + args[0] + "}"
+	case c.Name == "abs" && len(args) == 1:
+		return `\left|` + args[0] + `\right|`
+	case c.Name == "exp" && len(args) == 1:
+		return "e^{" + args[0] + "}"
+	}
+	return `\operatorname{` + c.Name + `}\left(` + strings.Join(args, ", ") + `\right)`
+}
+
+// latexWrap 按需给子表达式加LaTeX括号
+func latexWrap(n Node, parens bool) string {
+	if parens {
+		return `\left(` + n.LaTeX() + `\right)`
+	}
+	return n.LaTeX()
+}
+
+// startsWithLetter 判断表达式的LaTeX形式是否以字母或命令开头，数字与之相邻时可省略乘号
+func startsWithLetter(n Node) bool {
+	switch n := n.(type) {
+	case Variable, Call:
+		return true
+	case Binary:
+		return n.Op == '^' && startsWithLetter(n.Left)
+	}
+	return false
+}
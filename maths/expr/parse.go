@@ -0,0 +1,275 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+// token 词法单元
+type token struct {
+	kind  byte // 'n' 数字, 'i' 标识符, 其余为运算符或括号本身, 0 表示结束
+	text  string
+	value float64
+	pos   int
+}
+
+var (
+	errUnexpectedChar  = "位置%d：无法识别的字符%q"
+	errUnexpectedToken = "位置%d：此处不应出现%q"
+	errUnexpectedEnd   = "表达式不完整"
+	errBadNumber       = "位置%d：无效的数字%q"
+)
+
+// tokenize 将表达式文本切分为词法单元
+func tokenize(src string) ([]token, error) {
+	runes := []rune(src)
+	var tokens []token
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			// 科学计数法：e后须紧跟数字或带符号的数字，如 1e-07 与 2e-1 = 0.2；
+			// 单独的 2e 仍是与常数e的隐式乘法，要表示 2·e - 1 须写 2*e-1
+			if i+1 < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if runes[j] == '+' || runes[j] == '-' {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					i = j
+					for i < len(runes) && unicode.IsDigit(runes[i]) {
+						i++
+					}
+				}
+			}
+			text := string(runes[start:i])
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf(errBadNumber, start, text)
+			}
+			tokens = append(tokens, token{kind: 'n', text: text, value: value, pos: start})
+		case r == 'π':
+			// π本身也是字母，须在标识符之前处理
+			tokens = append(tokens, token{kind: 'i', text: "pi", pos: i})
+			i++
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && runes[i] != 'π' && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: 'i', text: string(runes[start:i]), pos: start})
+		case r < 128 && isOperator(byte(r)):
+			tokens = append(tokens, token{kind: byte(r), text: string(r), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf(errUnexpectedChar, i, r)
+		}
+	}
+	return append(tokens, token{pos: len(runes)}), nil
+}
+
+// isOperator 判断是否为运算符、括号或逗号
+func isOperator(c byte) bool {
+	switch c {
+	case '+', '-', '*', '/', '^', '(', ')', ',':
+		return true
+	}
+	return false
+}
+
+// parser 递归下降语法分析器
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary | primary }   相邻因子视为隐式乘法，如 2x
+//	unary   = "-" unary | "+" unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | ident | ident "(" expr { "," expr } ")" | "(" expr ")"
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse 将表达式文本解析为语法树
+func Parse(src string) (Node, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	node, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != 0 {
+		return nil, fmt.Errorf(errUnexpectedToken, tok.pos, tok.text)
+	}
+	return node, nil
+}
+
+// MustParse 解析表达式，出错时panic，用于书写固定的公式
+func MustParse(src string) Node {
+	node, err := Parse(src)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != 0 {
+		p.pos++
+	}
+	return tok
+}
+
+// unexpected 针对当前词法单元生成错误
+func (p *parser) unexpected() error {
+	tok := p.peek()
+	if tok.kind == 0 {
+		return errors.New(errUnexpectedEnd)
+	}
+	return fmt.Errorf(errUnexpectedToken, tok.pos, tok.text)
+}
+
+func (p *parser) expr() (Node, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == '+' || p.peek().kind == '-' {
+		op := p.next().kind
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = Binary{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) term() (Node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := byte('*')
+		switch p.peek().kind {
+		case '*', '/':
+			op = p.next().kind
+		case 'n', 'i', '(':
+			// 隐式乘法
+		default:
+			return left, nil
+		}
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = Binary{Op: op, Left: left, Right: right}
+	}
+}
+
+func (p *parser) unary() (Node, error) {
+	switch p.peek().kind {
+	case '-':
+		p.next()
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return Unary{Operand: operand}, nil
+	case '+':
+		p.next()
+		return p.unary()
+	}
+	return p.power()
+}
+
+func (p *parser) power() (Node, error) {
+	base, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != '^' {
+		return base, nil
+	}
+	p.next()
+	exponent, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return Binary{Op: '^', Left: base, Right: exponent}, nil
+}
+
+func (p *parser) primary() (Node, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case 'n':
+		p.next()
+		return Number{Value: tok.value}, nil
+	case 'i':
+		p.next()
+		fn, isFunc := functions[tok.text]
+		if !isFunc {
+			// 变量后紧跟括号按隐式乘法处理，如 x(x+1)
+			return Variable{Name: tok.text}, nil
+		}
+		if p.peek().kind != '(' {
+			// 函数名后须紧跟括号，sin x 这类写法容易与变量相乘混淆
+			return nil, p.unexpected()
+		}
+		p.next()
+		var args []Node
+		for {
+			arg, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.peek().kind != ',' {
+				break
+			}
+			p.next()
+		}
+		if p.peek().kind != ')' {
+			return nil, p.unexpected()
+		}
+		p.next()
+		if len(args) != fn.arity {
+			return nil, fmt.Errorf(errArity, tok.text, fn.arity)
+		}
+		return Call{Name: tok.text, Args: args}, nil
+	case '(':
+		p.next()
+		inner, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().kind != ')' {
+			return nil, p.unexpected()
+		}
+		p.next()
+		return inner, nil
+	}
+	return nil, p.unexpected()
+}
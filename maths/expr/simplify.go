@@ -0,0 +1,201 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package expr
+
+import "math"
+
+// Simplify 化简表达式：常数折叠，并消去 x+0、x*1、x*0、x^1、x^0、-(-x) 等平凡结构
+// 求值出错的常数子式（如 log(1, 2)）保留原样，留待求值时报告错误；
+// x-x → 0、x*0 → 0 等会丢掉子式的规则只用于不会求值出错的子式（见total），
+// x/x、0/x 在x = 0时出错，不作化简，以免掩盖定义域错误；
+// 函数调用只在结果为整数时折叠（如 ln(1)、sqrt(4)），ln(2) 等超越常数保留符号形式
+func Simplify(n Node) Node {
+	switch n := n.(type) {
+	case Unary:
+		operand := Simplify(n.Operand)
+		switch o := operand.(type) {
+		case Number:
+			return number(-o.Value)
+		case Unary:
+			return o.Operand
+		}
+		return Unary{Operand: operand}
+	case Binary:
+		return simplifyBinary(n.Op, Simplify(n.Left), Simplify(n.Right))
+	case Call:
+		args := make([]Node, len(n.Args))
+		constant := true
+		for i, arg := range n.Args {
+			args[i] = Simplify(arg)
+			if _, ok := args[i].(Number); !ok {
+				constant = false
+			}
+		}
+		call := Call{Name: n.Name, Args: args}
+		if constant {
+			if value, err := call.Eval(nil); err == nil && value == math.Trunc(value) {
+				return number(value)
+			}
+		}
+		return call
+	}
+	return n
+}
+
+// simplifyBinary 化简已化简子式构成的二元运算
+func simplifyBinary(op byte, left, right Node) Node {
+	l, lok := left.(Number)
+	r, rok := right.(Number)
+	node := Binary{Op: op, Left: left, Right: right}
+	if lok && rok {
+		if value, err := node.Eval(nil); err == nil {
+			return number(value)
+		}
+		return node
+	}
+	switch op {
+	case '+':
+		if lok && l.Value == 0 {
+			return right
+		}
+		if rok && r.Value == 0 {
+			return left
+		}
+		if u, ok := right.(Unary); ok {
+			return Binary{Op: '-', Left: left, Right: u.Operand}
+		}
+		if rok && r.Value < 0 {
+			return Binary{Op: '-', Left: left, Right: Number{Value: -r.Value}}
+		}
+	case '-':
+		if rok && r.Value == 0 {
+			return left
+		}
+		if lok && l.Value == 0 {
+			return Simplify(Unary{Operand: right})
+		}
+		if u, ok := right.(Unary); ok {
+			return Binary{Op: '+', Left: left, Right: u.Operand}
+		}
+		if rok && r.Value < 0 {
+			return Binary{Op: '+', Left: left, Right: Number{Value: -r.Value}}
+		}
+		if Equal(left, right) && total(left) {
+			return Number{Value: 0}
+		}
+	case '*':
+		if (lok && l.Value == 0 && total(right)) || (rok && r.Value == 0 && total(left)) {
+			return Number{Value: 0}
+		}
+		if lok && l.Value == 1 {
+			return right
+		}
+		if rok && r.Value == 1 {
+			return left
+		}
+		if lok && l.Value == -1 {
+			return Simplify(Unary{Operand: right})
+		}
+		if rok {
+			// 常数因子统一放在左侧，如 x*2 → 2*x
+			return simplifyBinary('*', right, left)
+		}
+		// 负号提到乘积之外，如 a*(-b) → -(a*b)
+		if u, ok := left.(Unary); ok {
+			return Simplify(Unary{Operand: simplifyBinary('*', u.Operand, right)})
+		}
+		if u, ok := right.(Unary); ok {
+			return Simplify(Unary{Operand: simplifyBinary('*', left, u.Operand)})
+		}
+		// 倒数与因子相乘化为商，如 (1/a)*b → b/a
+		if q, ok := left.(Binary); ok && q.Op == '/' && isOne(q.Left) {
+			return simplifyBinary('/', right, q.Right)
+		}
+		if q, ok := right.(Binary); ok && q.Op == '/' && isOne(q.Left) {
+			return simplifyBinary('/', left, q.Right)
+		}
+		if inner, ok := right.(Binary); ok && lok && inner.Op == '*' {
+			// 2*(3*x) → 6*x
+			if c, ok := inner.Left.(Number); ok {
+				return simplifyBinary('*', Number{Value: l.Value * c.Value}, inner.Right)
+			}
+		}
+	case '/':
+		if rok && r.Value == 1 {
+			return left
+		}
+	case '^':
+		if rok && r.Value == 0 && total(left) {
+			return Number{Value: 1}
+		}
+		if rok && r.Value == 1 {
+			return left
+		}
+		if lok && l.Value == 1 && total(right) {
+			return Number{Value: 1}
+		}
+	}
+	return node
+}
+
+// number 构造数值常量，并把 -0 规范为 0
+func number(v float64) Number {
+	if v == 0 {
+		v = 0
+	}
+	return Number{Value: v}
+}
+
+// total 判断子式是否在任何取值下都不会求值出错：只由数、变量、负号与加减乘构成
+// 除法、乘方与函数调用都有定义域限制，化简时不能整体丢弃
+func total(n Node) bool {
+	switch n := n.(type) {
+	case Number, Variable:
+		return true
+	case Unary:
+		return total(n.Operand)
+	case Binary:
+		return (n.Op == '+' || n.Op == '-' || n.Op == '*') && total(n.Left) && total(n.Right)
+	}
+	return false
+}
+
+// isOne 判断节点是否为常数1
+func isOne(n Node) bool {
+	num, ok := n.(Number)
+	return ok && num.Value == 1
+}
+
+// Equal 判断两棵语法树在结构上是否相同
+func Equal(a, b Node) bool {
+	switch a := a.(type) {
+	case Number:
+		b, ok := b.(Number)
+		return ok && a.Value == b.Value
+	case Variable:
+		b, ok := b.(Variable)
+		return ok && a.Name == b.Name
+	case Unary:
+		b, ok := b.(Unary)
+		return ok && Equal(a.Operand, b.Operand)
+	case Binary:
+		b, ok := b.(Binary)
+		return ok && a.Op == b.Op && Equal(a.Left, b.Left) && Equal(a.Right, b.Right)
+	case Call:
+		b, ok := b.(Call)
+		if !ok || a.Name != b.Name || len(a.Args) != len(b.Args) {
+			return false
+		}
+		for i := range a.Args {
+			if !Equal(a.Args[i], b.Args[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
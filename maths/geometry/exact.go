@@ -0,0 +1,151 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package geometry
+
+import (
+	"errors"
+	"math/big"
+
+	"guts/maths/algebra"
+)
+
+var (
+	notSpecialAngle = "精确值只支持30°与45°的整数倍角"
+)
+
+// specialCos 特殊角余弦值表，键为[0°, 360°)内30°或45°的整数倍
+var specialCos = map[int][3]int64{
+	// {有理部分分子, 根式系数分子, 被开方数}，分母统一为2
+	0:   {2, 0, 1},
+	30:  {0, 1, 3},
+	45:  {0, 1, 2},
+	60:  {1, 0, 1},
+	90:  {0, 0, 1},
+	120: {-1, 0, 1},
+	135: {0, -1, 2},
+	150: {0, -1, 3},
+	180: {-2, 0, 1},
+	210: {0, -1, 3},
+	225: {0, -1, 2},
+	240: {-1, 0, 1},
+	270: {0, 0, 1},
+	300: {1, 0, 1},
+	315: {0, 1, 2},
+	330: {0, 1, 3},
+}
+
+// CosExact 特殊角余弦的精确值（角度制）
+func CosExact(deg int) (algebra.Surd, error) {
+	deg = ((deg % 360) + 360) % 360
+	entry, ok := specialCos[deg]
+	if !ok {
+		return algebra.Surd{}, errors.New(notSpecialAngle)
+	}
+	return algebra.NewSurd(big.NewRat(entry[0], 2), big.NewRat(entry[1], 2), entry[2])
+}
+
+// SinExact 特殊角正弦的精确值（角度制）：sinθ = cos(90°-θ)
+func SinExact(deg int) (algebra.Surd, error) {
+	return CosExact(90 - deg)
+}
+
+// sumOfProducts 精确计算 x₁y₁ + sign·x₂y₂
+func sumOfProducts(x1, y1, x2, y2 algebra.Surd, sign int) algebra.Surd {
+	if sign < 0 {
+		return x1.Mul(y1).Sub(x2.Mul(y2))
+	}
+	return x1.Mul(y1).Add(x2.Mul(y2))
+}
+
+// specialPair 同时取特殊角的正弦与余弦精确值
+func specialPair(deg int) (algebra.Surd, algebra.Surd, error) {
+	sin, err := SinExact(deg)
+	if err != nil {
+		return algebra.Surd{}, algebra.Surd{}, err
+	}
+	cos, err := CosExact(deg)
+	if err != nil {
+		return algebra.Surd{}, algebra.Surd{}, err
+	}
+	return sin, cos, nil
+}
+
+// CosAddExact 和角余弦公式的精确值：cos(A+B) = cosAcosB - sinAsinB（角度制）
+func CosAddExact(degA, degB int) (algebra.Surd, error) {
+	sinA, cosA, err := specialPair(degA)
+	if err != nil {
+		return algebra.Surd{}, err
+	}
+	sinB, cosB, err := specialPair(degB)
+	if err != nil {
+		return algebra.Surd{}, err
+	}
+	return sumOfProducts(cosA, cosB, sinA, sinB, -1), nil
+}
+
+// CosSubExact 差角余弦公式的精确值：cos(A-B) = cosAcosB + sinAsinB（角度制）
+func CosSubExact(degA, degB int) (algebra.Surd, error) {
+	return CosAddExact(degA, -degB)
+}
+
+// SinAddExact 和角正弦公式的精确值：sin(A+B) = sinAcosB + cosAsinB（角度制）
+func SinAddExact(degA, degB int) (algebra.Surd, error) {
+	sinA, cosA, err := specialPair(degA)
+	if err != nil {
+		return algebra.Surd{}, err
+	}
+	sinB, cosB, err := specialPair(degB)
+	if err != nil {
+		return algebra.Surd{}, err
+	}
+	return sumOfProducts(sinA, cosB, cosA, sinB, 1), nil
+}
+
+// SinSubExact 差角正弦公式的精确值：sin(A-B) = sinAcosB - cosAsinB（角度制）
+func SinSubExact(degA, degB int) (algebra.Surd, error) {
+	return SinAddExact(degA, -degB)
+}
+
+// LawOfCosinesExact 余弦定理的精确值：已知两边a、b及夹角C（角度制）求对边c
+// C为30°、45°的奇数倍时 c² = p + q√k，只有 p² - q²k 为完全平方数时c才能化为根式之和，
+// 例如 a = b = 1、C = 30° 时 c = (√6 - √2)/2；C = 45° 时 c = √(2 - √2) 无法去掉嵌套根号，返回错误
+func LawOfCosinesExact(a, b *big.Rat, degC int) (algebra.Surd, error) {
+	if a.Sign() <= 0 || b.Sign() <= 0 {
+		return algebra.Surd{}, errors.New(lengthNegative)
+	}
+	if degC <= 0 || degC >= 180 {
+		return algebra.Surd{}, errors.New(angleOutRange)
+	}
+	cos, err := CosExact(degC)
+	if err != nil {
+		return algebra.Surd{}, err
+	}
+	squares := new(big.Rat).Add(new(big.Rat).Mul(a, a), new(big.Rat).Mul(b, b))
+	twoAB := new(big.Rat).Mul(a, b)
+	twoAB.Mul(twoAB, big.NewRat(2, 1))
+	cSquared := algebra.NewRational(squares).Sub(cos.Mul(algebra.NewRational(twoAB)))
+	return cSquared.Sqrt()
+}
+
+// HeronFormulaExact 海伦公式的精确值：三边为有理数时面积为 √(s(s-a)(s-b)(s-c))
+func HeronFormulaExact(a, b, c *big.Rat) (algebra.Surd, error) {
+	if a.Sign() <= 0 || b.Sign() <= 0 || c.Sign() <= 0 {
+		return algebra.Surd{}, errors.New(lengthNegative)
+	}
+	sum := new(big.Rat).Add(a, b)
+	sum.Add(sum, c)
+	s := new(big.Rat).Quo(sum, big.NewRat(2, 1))
+	areaSquared := new(big.Rat).Set(s)
+	for _, side := range []*big.Rat{a, b, c} {
+		diff := new(big.Rat).Sub(s, side)
+		if diff.Sign() <= 0 {
+			return algebra.Surd{}, errors.New(calibrationFail)
+		}
+		areaSquared.Mul(areaSquared, diff)
+	}
+	return algebra.SqrtRat(areaSquared)
+}
@@ -9,14 +9,21 @@ package geometry
 import (
 	"errors"
 	"math"
+
+	"guts/maths/sets"
+	"guts/maths/solver"
 )
 
 var (
 	outDefinition = "函数在此时没有定义"
 	outRange      = "正余弦值超出范围"
 	outRule       = "Omega不得为零"
+	outFinite     = "区间端点须为有限数"
+	outTooWide    = "区间内的间断点过多"
 )
 
+const maxPoles = 1 << 16 // TanDomain最多列出的间断点个数
+
 // isInRange 检查值是否在[min, max]范围内
 func isInRange(value, min, max float64) bool {
 	return value >= min && value <= max
@@ -40,6 +47,24 @@ func Tan(rad float64) (float64, error) {
 	return math.Tan(rad), nil
 }
 
+// TanDomain 正切函数在[lo, hi]内的定义域：去掉 π/2 + kπ
+// 端点须为有限数，区间内的间断点不得超过maxPoles个
+func TanDomain(lo, hi float64) (sets.IntervalSet, error) {
+	if math.IsNaN(lo) || math.IsNaN(hi) || math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+		return sets.IntervalSet{}, errors.New(outFinite)
+	}
+	first := math.Ceil((lo - math.Pi/2) / math.Pi)
+	last := math.Floor((hi - math.Pi/2) / math.Pi)
+	if last-first+1 > maxPoles {
+		return sets.IntervalSet{}, errors.New(outTooWide)
+	}
+	var poles []float64
+	for k := first; k <= last; k++ {
+		poles = append(poles, math.Pi/2+k*math.Pi)
+	}
+	return sets.NewIntervalSet(sets.Closed(lo, hi)).Difference(sets.Points(poles...)), nil
+}
+
 // DegToRad 角度转弧度
 func DegToRad(deg float64) float64 {
 	return deg * math.Pi / 180
@@ -143,6 +168,21 @@ func CosCosToSum(radA, radB float64) (float64, float64) {
 	return 0.5 * Cos(radA-radB), 0.5 * Cos(radA+radB)
 }
 
+// SinHalfDomain 半角正弦公式中cosθ的取值范围[-1, 1]
+func SinHalfDomain() sets.IntervalSet {
+	return sets.NewIntervalSet(sets.Closed(-1, 1))
+}
+
+// CosHalfDomain 半角余弦公式中cosθ的取值范围[-1, 1]
+func CosHalfDomain() sets.IntervalSet {
+	return sets.NewIntervalSet(sets.Closed(-1, 1))
+}
+
+// TanHalfDomain 半角正切公式中cosθ的取值范围(-1, 1]
+func TanHalfDomain() sets.IntervalSet {
+	return sets.NewIntervalSet(sets.Interval{Left: -1, Right: 1, RightClosed: true})
+}
+
 // SinHalf 半角正弦公式：sin(θ/2)
 func SinHalf(cos float64) (float64, error) {
 	if !isInRange(cos, -1, 1) {
@@ -207,3 +247,10 @@ func Period(w float64) (float64, error) {
 	}
 	return 2 * math.Pi / w, nil
 }
+
+// TrigEquationRoots 求三角方程 f(x) = 0 在[a, b]上的全部解（弧度），如 sin2x - cosx = 0
+// 按每1°一个分点扫描，同一度内的多个解可能被合并
+func TrigEquationRoots(f func(float64) float64, a, b float64) ([]float64, error) {
+	samples := int(math.Ceil(RadToDeg(b - a)))
+	return solver.FindAllRoots(f, a, b, max(samples, 1), solver.MethodBrent, solver.Options{})
+}
@@ -0,0 +1,256 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package inequality
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"guts/maths/algebra"
+	"guts/maths/sets"
+)
+
+// Relation 不等号
+type Relation int
+
+const (
+	Less         Relation = iota // <
+	LessEqual                    // ≤
+	Greater                      // >
+	GreaterEqual                 // ≥
+)
+
+var (
+	errUnknownRelation = "无法识别的不等号"
+	errZeroDenominator = "分母不得为零多项式"
+)
+
+const zeroTolerance = 1e-9 // 差值绝对值小于此值时视为相等
+
+// ParseRelation 由 <、<=、≤、>、>=、≥ 解析不等号
+func ParseRelation(s string) (Relation, error) {
+	switch s {
+	case "<":
+		return Less, nil
+	case "<=", "≤":
+		return LessEqual, nil
+	case ">":
+		return Greater, nil
+	case ">=", "≥":
+		return GreaterEqual, nil
+	}
+	return 0, errors.New(errUnknownRelation)
+}
+
+// String 不等号的文本形式，无效的取值输出"?"
+func (r Relation) String() string {
+	if r < Less || r > GreaterEqual {
+		return "?"
+	}
+	return [...]string{"<", "≤", ">", "≥"}[r]
+}
+
+// Flip 不等式两边同乘负数或取倒数后的不等号，无效的取值原样返回
+func (r Relation) Flip() Relation {
+	if r < Less || r > GreaterEqual {
+		return r
+	}
+	return [...]Relation{Greater, GreaterEqual, Less, LessEqual}[r]
+}
+
+// holds 判断 diff rel 0 是否成立，|diff|极小时视为等于零
+func (r Relation) holds(diff float64) bool {
+	if math.Abs(diff) < zeroTolerance {
+		diff = 0
+	}
+	switch r {
+	case Less:
+		return diff < 0
+	case LessEqual:
+		return diff <= 0
+	case Greater:
+		return diff > 0
+	}
+	return diff >= 0
+}
+
+// SolvePolynomial 解多项式不等式 p(x) rel 0，穿针引线法：实根把数轴分段，逐段检验符号
+func SolvePolynomial(p algebra.Polynomial, rel Relation) (sets.IntervalSet, error) {
+	roots, err := realRoots(p)
+	if err != nil {
+		return sets.IntervalSet{}, err
+	}
+	return solveBySigns(roots, func(x float64) (float64, bool) {
+		return p.Eval(x), true
+	}, rel), nil
+}
+
+// SolveRational 解分式不等式 num(x)/den(x) rel 0，分母的零点总被排除
+func SolveRational(num, den algebra.Polynomial, rel Relation) (sets.IntervalSet, error) {
+	if den.Degree() < 0 {
+		return sets.IntervalSet{}, errors.New(errZeroDenominator)
+	}
+	numRoots, err := realRoots(num)
+	if err != nil {
+		return sets.IntervalSet{}, err
+	}
+	denRoots, err := realRoots(den)
+	if err != nil {
+		return sets.IntervalSet{}, err
+	}
+	// 分式与 num·den 同号，用乘积判断符号可避开分母接近零时的数值放大
+	return solveBySigns(append(numRoots, denRoots...), func(x float64) (float64, bool) {
+		d := den.Eval(x)
+		if onRoot(x, denRoots) || d == 0 {
+			return 0, false
+		}
+		return num.Eval(x) * d, true
+	}, rel), nil
+}
+
+// SolveAbsolute 解绝对值不等式 |f(x)| rel g(x)
+// 分界点取 f = 0、f = g、f = -g 的实根，各段内绝对值可直接去掉
+func SolveAbsolute(f, g algebra.Polynomial, rel Relation) (sets.IntervalSet, error) {
+	var critical []float64
+	for _, p := range []algebra.Polynomial{f, f.Sub(g), f.Add(g)} {
+		roots, err := realRoots(p)
+		if err != nil {
+			return sets.IntervalSet{}, err
+		}
+		critical = append(critical, roots...)
+	}
+	return solveBySigns(critical, func(x float64) (float64, bool) {
+		return math.Abs(f.Eval(x)) - g.Eval(x), true
+	}, rel), nil
+}
+
+// SolveLog 解对数不等式 log_a(f(x)) rel c，定义域 f(x) > 0 由algebra.CheckLogValidity校验
+// 底数大于1时等价于 f(x) rel aᶜ，底数在(0, 1)时不等号反向
+func SolveLog(base float64, f algebra.Polynomial, rel Relation, c float64) (sets.IntervalSet, error) {
+	if _, err := algebra.CheckLogValidity(base, 1); err != nil {
+		return sets.IntervalSet{}, err
+	}
+	bound := math.Pow(base, c)
+	var critical []float64
+	for _, p := range []algebra.Polynomial{f, f.Sub(algebra.Polynomial{bound})} {
+		roots, err := realRoots(p)
+		if err != nil {
+			return sets.IntervalSet{}, err
+		}
+		critical = append(critical, roots...)
+	}
+	return solveBySigns(critical, func(x float64) (float64, bool) {
+		value, err := algebra.Log(base, f.Eval(x))
+		if err != nil {
+			return 0, false
+		}
+		return value - c, true
+	}, rel), nil
+}
+
+// SolveExp 解指数不等式 a^f(x) rel c
+// c ≤ 0 时由指数函数恒正直接得出，否则两边取以a为底的对数化为多项式不等式
+func SolveExp(base float64, f algebra.Polynomial, rel Relation, c float64) (sets.IntervalSet, error) {
+	if _, err := algebra.CheckLogValidity(base, 1); err != nil {
+		return sets.IntervalSet{}, err
+	}
+	if c <= 0 {
+		if rel == Greater || rel == GreaterEqual {
+			return sets.Reals(), nil
+		}
+		return sets.IntervalSet{}, nil
+	}
+	exponent, _ := algebra.Log(base, c)
+	if base < 1 {
+		rel = rel.Flip()
+	}
+	return SolvePolynomial(f.Sub(algebra.Polynomial{exponent}), rel)
+}
+
+// realRoots 多项式的全部实根（去重、升序），零多项式与非零常数没有分界点
+func realRoots(p algebra.Polynomial) ([]float64, error) {
+	if p.Degree() < 1 {
+		return nil, nil
+	}
+	var roots []algebra.Complex
+	var err error
+	if p.Degree() <= 4 {
+		roots, err = p.Solve()
+	} else {
+		roots, err = p.Roots()
+	}
+	if err != nil {
+		return nil, err
+	}
+	var result []float64
+	for _, r := range roots {
+		if math.Abs(r.Imaginary) < 1e-6*math.Max(1, math.Abs(r.Real)) {
+			result = append(result, r.Real)
+		}
+	}
+	return dedupe(result), nil
+}
+
+// dedupe 排序并合并几乎相同的点
+func dedupe(xs []float64) []float64 {
+	sort.Float64s(xs)
+	var result []float64
+	for _, x := range xs {
+		if n := len(result); n > 0 && math.Abs(x-result[n-1]) < 1e-7*math.Max(1, math.Abs(x)) {
+			continue
+		}
+		result = append(result, x)
+	}
+	return result
+}
+
+// onRoot 判断x是否为给定的根之一
+func onRoot(x float64, roots []float64) bool {
+	for _, r := range roots {
+		if x == r {
+			return true
+		}
+	}
+	return false
+}
+
+// solveBySigns 分界点把数轴分为若干开区间与分界点本身，逐一检验不等式
+// value返回不等式左边减右边的值，第二个返回值为false表示该点不在定义域内
+func solveBySigns(critical []float64, value func(x float64) (float64, bool), rel Relation) sets.IntervalSet {
+	points := dedupe(critical)
+	test := func(x float64) bool {
+		diff, ok := value(x)
+		return ok && rel.holds(diff)
+	}
+	var intervals []sets.Interval
+	bounds := append(append([]float64{math.Inf(-1)}, points...), math.Inf(1))
+	for i := 0; i+1 < len(bounds); i++ {
+		if test(samplePoint(bounds[i], bounds[i+1])) {
+			intervals = append(intervals, sets.Open(bounds[i], bounds[i+1]))
+		}
+	}
+	for _, x := range points {
+		if test(x) {
+			intervals = append(intervals, sets.Point(x))
+		}
+	}
+	return sets.NewIntervalSet(intervals...)
+}
+
+// samplePoint 在开区间(a, b)内选一个检验点
+func samplePoint(a, b float64) float64 {
+	switch {
+	case math.IsInf(a, -1) && math.IsInf(b, 1):
+		return 0
+	case math.IsInf(a, -1):
+		return b - 1
+	case math.IsInf(b, 1):
+		return a + 1
+	}
+	return (a + b) / 2
+}
@@ -0,0 +1,91 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package numtheory
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	errZeroLCM    = "零与任何数的最小公倍数没有意义"
+	errOverflow   = "结果超出int64范围"
+	errEmptyInput = "不得传入空集"
+)
+
+// absU 整数的绝对值，用uint64表示，math.MinInt64的绝对值2⁶³也不会溢出
+func absU(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
+
+// GCD 辗转相除法求最大公约数，gcd(math.MinInt64, 0) = 2⁶³超出int64，因此结果用uint64表示
+func GCD(a, b int64) uint64 {
+	return gcdU(absU(a), absU(b))
+}
+
+// ExtendedGCD 扩展欧几里得算法：求g = gcd(a, b)及满足 ax + by = g 的一组整数解
+func ExtendedGCD(a, b int64) (int64, int64, int64) {
+	oldR, r := a, b
+	oldX, x := int64(1), int64(0)
+	oldY, y := int64(0), int64(1)
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		oldR, oldX, oldY = -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// LCM 最小公倍数：lcm(a, b) = |ab|/gcd(a, b)
+func LCM(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, errors.New(errZeroLCM)
+	}
+	ua, ub := absU(a), absU(b)
+	q := ua / gcdU(ua, ub)
+	if q > math.MaxInt64/ub {
+		return 0, errors.New(errOverflow)
+	}
+	return int64(q * ub), nil
+}
+
+// GCDOf 多个数的最大公约数
+func GCDOf(nums ...int64) (uint64, error) {
+	if len(nums) == 0 {
+		return 0, errors.New(errEmptyInput)
+	}
+	var g uint64
+	for _, n := range nums {
+		g = gcdU(g, absU(n))
+	}
+	return g, nil
+}
+
+// LCMOf 多个数的最小公倍数
+func LCMOf(nums ...int64) (int64, error) {
+	if len(nums) == 0 {
+		return 0, errors.New(errEmptyInput)
+	}
+	// lcm(a) = |a|，同样要检查零与溢出
+	l, err := LCM(nums[0], 1)
+	if err != nil {
+		return 0, err
+	}
+	for _, n := range nums[1:] {
+		if l, err = LCM(l, n); err != nil {
+			return 0, err
+		}
+	}
+	return l, nil
+}
@@ -0,0 +1,91 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package numtheory
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	errModulus      = "模数须为正整数"
+	errNoInverse    = "a与m不互质，模逆元不存在"
+	errCRTLength    = "余数与模数的个数须相同且不为零"
+	errCRTNoSolve   = "同余方程组无解"
+	errNegativeExpo = "指数为负时要求底数与模数互质"
+)
+
+// normalizeMod 把a化为[0, m)内的代表元
+func normalizeMod(a, m int64) int64 {
+	a %= m
+	if a < 0 {
+		a += m
+	}
+	return a
+}
+
+// ModPow 快速幂 a^e mod m，e为负时先求a的模逆元
+func ModPow(a, e, m int64) (int64, error) {
+	if m <= 0 {
+		return 0, errors.New(errModulus)
+	}
+	base := normalizeMod(a, m)
+	if e < 0 {
+		inv, err := ModInverse(base, m)
+		if err != nil {
+			return 0, errors.New(errNegativeExpo)
+		}
+		base, e = inv, -e
+	}
+	return int64(powMod(uint64(base), uint64(e), uint64(m))), nil
+}
+
+// ModInverse 模逆元：求x使 ax ≡ 1 (mod m)，由扩展欧几里得算法得到
+func ModInverse(a, m int64) (int64, error) {
+	if m <= 0 {
+		return 0, errors.New(errModulus)
+	}
+	g, x, _ := ExtendedGCD(normalizeMod(a, m), m)
+	if g != 1 {
+		return 0, errors.New(errNoInverse)
+	}
+	return normalizeMod(x, m), nil
+}
+
+// CRT 中国剩余定理：解同余方程组 x ≡ rᵢ (mod mᵢ)，模数不必两两互质
+// 返回最小非负解x与模数M = lcm(m₁, …, mₙ)，通解为 x + kM
+func CRT(remainders, moduli []int64) (int64, int64, error) {
+	if len(remainders) == 0 || len(remainders) != len(moduli) {
+		return 0, 0, errors.New(errCRTLength)
+	}
+	// 中间结果可能超出int64，合并过程统一用大整数
+	x, m := big.NewInt(0), big.NewInt(1)
+	for i, mi := range moduli {
+		if mi <= 0 {
+			return 0, 0, errors.New(errModulus)
+		}
+		ri := big.NewInt(normalizeMod(remainders[i], mi))
+		bm := big.NewInt(mi)
+		// 求t使 x + m·t ≡ rᵢ (mod mᵢ)，即 m·t ≡ rᵢ - x (mod mᵢ)
+		g, p := new(big.Int), new(big.Int)
+		g.GCD(p, nil, m, bm)
+		diff := new(big.Int).Sub(ri, x)
+		if new(big.Int).Mod(diff, g).Sign() != 0 {
+			return 0, 0, errors.New(errCRTNoSolve)
+		}
+		step := new(big.Int).Quo(bm, g)
+		t := new(big.Int).Mul(new(big.Int).Quo(diff, g), p)
+		t.Mod(t, step)
+		x.Add(x, new(big.Int).Mul(m, t))
+		m.Mul(m, step)
+		x.Mod(x, m)
+	}
+	if !m.IsInt64() {
+		return 0, 0, errors.New(errOverflow)
+	}
+	return x.Int64(), m.Int64(), nil
+}
@@ -0,0 +1,397 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package numtheory
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	"sort"
+)
+
+// Factor 质因数及其指数
+type Factor struct {
+	Prime    uint64
+	Exponent int
+}
+
+// BigFactor 大整数的质因数及其指数
+type BigFactor struct {
+	Prime    *big.Int
+	Exponent int
+}
+
+var (
+	errSieveLimit  = "筛法上限过大"
+	errFactorZero  = "零没有质因数分解"
+	errTotientZero = "欧拉函数只对正整数有定义"
+	errFactorSign  = "只能分解正整数"
+	errFactorLimit = "整数过大，无法在限定步数内分解质因数"
+)
+
+const (
+	maxSieveLimit      = 1 << 26 // 埃氏筛允许的最大上限，筛表约占64MB内存
+	trialDivisionLimit = 10000   // FactorizeBig试除的上界，更大的因子交给波拉德ρ算法
+	rhoIterationLimit  = 1 << 22 // 单次pollardRhoBig的最大迭代步数
+)
+
+// millerRabinBases 对所有uint64确定性成立的米勒–拉宾测试底数
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// Sieve 埃拉托斯特尼筛法：返回不超过n的全部质数，n超过maxSieveLimit（2²⁶）时返回错误
+func Sieve(n int) ([]int, error) {
+	if n > maxSieveLimit {
+		return nil, errors.New(errSieveLimit)
+	}
+	if n < 2 {
+		return nil, nil
+	}
+	composite := make([]bool, n+1)
+	var primes []int
+	for i := 2; i <= n; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j <= n; j += i {
+			composite[j] = true
+		}
+	}
+	return primes, nil
+}
+
+// mulMod 计算 a·b mod m，借助128位乘积避免溢出
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, rem := bits.Div64(hi%m, lo, m)
+	return rem
+}
+
+// powMod 快速幂 a^e mod m
+func powMod(a, e, m uint64) uint64 {
+	result := uint64(1) % m
+	a %= m
+	for e > 0 {
+		if e&1 == 1 {
+			result = mulMod(result, a, m)
+		}
+		a = mulMod(a, a, m)
+		e >>= 1
+	}
+	return result
+}
+
+// IsPrime 米勒–拉宾素性测试，对全部uint64取前12个质数为底即可确定性判断
+func IsPrime(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+	// n-1 = d·2ˢ
+	d, s := n-1, 0
+	for d%2 == 0 {
+		d /= 2
+		s++
+	}
+	for _, a := range millerRabinBases {
+		if !millerRabinRound(n, a, d, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// millerRabinRound 以a为底的一轮米勒–拉宾测试，返回false说明n必为合数
+func millerRabinRound(n, a, d uint64, s int) bool {
+	x := powMod(a, d, n)
+	if x == 1 || x == n-1 {
+		return true
+	}
+	for r := 1; r < s; r++ {
+		x = mulMod(x, x, n)
+		if x == n-1 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsProbablePrimeBig 大整数的米勒–拉宾测试，以前rounds个质数为底
+// 返回false时n必为合数，返回true时n为合数的概率不超过4^(-rounds)
+func IsProbablePrimeBig(n *big.Int, rounds int) bool {
+	two := big.NewInt(2)
+	if n.Cmp(two) < 0 {
+		return false
+	}
+	if n.IsUint64() {
+		return IsPrime(n.Uint64())
+	}
+	if n.Bit(0) == 0 {
+		return false
+	}
+	one := big.NewInt(1)
+	nMinus1 := new(big.Int).Sub(n, one)
+	d := new(big.Int).Set(nMinus1)
+	s := 0
+	for d.Bit(0) == 0 {
+		d.Rsh(d, 1)
+		s++
+	}
+	bases, _ := Sieve(1000)
+	if rounds <= 0 {
+		rounds = 20
+	}
+	for i := 0; i < rounds && i < len(bases); i++ {
+		x := new(big.Int).Exp(big.NewInt(int64(bases[i])), d, n)
+		if x.Cmp(one) == 0 || x.Cmp(nMinus1) == 0 {
+			continue
+		}
+		composite := true
+		for r := 1; r < s; r++ {
+			x.Mul(x, x).Mod(x, n)
+			if x.Cmp(nMinus1) == 0 {
+				composite = false
+				break
+			}
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+// Factorize 质因数分解：小因子试除，剩余的大合数用波拉德ρ算法拆分
+func Factorize(n uint64) ([]Factor, error) {
+	if n == 0 {
+		return nil, errors.New(errFactorZero)
+	}
+	counts := map[uint64]int{}
+	for _, p := range []uint64{2, 3, 5} {
+		for n%p == 0 {
+			counts[p]++
+			n /= p
+		}
+	}
+	for p := uint64(7); p < 1000 && p*p <= n; p += 2 {
+		for n%p == 0 {
+			counts[p]++
+			n /= p
+		}
+	}
+	if n > 1 {
+		collectFactors(n, counts)
+	}
+	factors := make([]Factor, 0, len(counts))
+	for p, e := range counts {
+		factors = append(factors, Factor{Prime: p, Exponent: e})
+	}
+	sort.Slice(factors, func(i, j int) bool { return factors[i].Prime < factors[j].Prime })
+	return factors, nil
+}
+
+// collectFactors 递归拆分n并累计各质因数的指数
+func collectFactors(n uint64, counts map[uint64]int) {
+	if n == 1 {
+		return
+	}
+	if IsPrime(n) {
+		counts[n]++
+		return
+	}
+	d := pollardRho(n)
+	collectFactors(d, counts)
+	collectFactors(n/d, counts)
+}
+
+// pollardRho 波拉德ρ算法（弗洛伊德判圈）：返回合数n的一个非平凡因子
+func pollardRho(n uint64) uint64 {
+	if n%2 == 0 {
+		return 2
+	}
+	for c := uint64(1); ; c++ {
+		f := func(x uint64) uint64 { return addMod(mulMod(x, x, n), c%n, n) }
+		x, y, d := uint64(2), uint64(2), uint64(1)
+		for d == 1 {
+			x = f(x)
+			y = f(f(y))
+			diff := x - y
+			if x < y {
+				diff = y - x
+			}
+			d = gcdU(diff, n)
+		}
+		if d != n {
+			return d
+		}
+	}
+}
+
+// addMod 计算 (a + b) mod m，要求 a、b < m，n接近2⁶⁴时直接相加会溢出
+func addMod(a, b, m uint64) uint64 {
+	if a >= m-b {
+		return a - (m - b)
+	}
+	return a + b
+}
+
+// FactorizeBig 大整数的质因数分解：uint64范围内交给Factorize，
+// 更大的数先试除小质数，再用米勒–拉宾判定素性、波拉德ρ算法拆分合数
+// 两个大质因数都超过约10¹³时可能在限定步数内分不开，此时返回错误
+func FactorizeBig(n *big.Int) ([]BigFactor, error) {
+	switch n.Sign() {
+	case 0:
+		return nil, errors.New(errFactorZero)
+	case -1:
+		return nil, errors.New(errFactorSign)
+	}
+	var primes []*big.Int
+	rest := new(big.Int).Set(n)
+	if !rest.IsUint64() {
+		small, _ := Sieve(trialDivisionLimit)
+		quo, mod := new(big.Int), new(big.Int)
+		for _, p := range small {
+			factor := big.NewInt(int64(p))
+			for quo.QuoRem(rest, factor, mod); mod.Sign() == 0; quo.QuoRem(rest, factor, mod) {
+				rest.Set(quo)
+				primes = append(primes, factor)
+			}
+		}
+	}
+	stack := []*big.Int{rest}
+	for len(stack) > 0 {
+		m := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		switch {
+		case m.IsUint64():
+			factors, _ := Factorize(m.Uint64())
+			for _, f := range factors {
+				for range f.Exponent {
+					primes = append(primes, new(big.Int).SetUint64(f.Prime))
+				}
+			}
+		case IsProbablePrimeBig(m, 20):
+			primes = append(primes, m)
+		default:
+			if root := new(big.Int).Sqrt(m); new(big.Int).Mul(root, root).Cmp(m) == 0 {
+				stack = append(stack, root, new(big.Int).Set(root))
+				continue
+			}
+			d, err := pollardRhoBig(m)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, d, new(big.Int).Quo(m, d))
+		}
+	}
+	sort.Slice(primes, func(i, j int) bool { return primes[i].Cmp(primes[j]) < 0 })
+	var factors []BigFactor
+	for _, p := range primes {
+		if k := len(factors) - 1; k >= 0 && factors[k].Prime.Cmp(p) == 0 {
+			factors[k].Exponent++
+			continue
+		}
+		factors = append(factors, BigFactor{Prime: p, Exponent: 1})
+	}
+	return factors, nil
+}
+
+// pollardRhoBig 波拉德ρ算法（布伦特判圈，批量求gcd）：返回大合数n的一个非平凡因子
+// uint64范围内的迭代很便宜，pollardRho用更简单的弗洛伊德判圈即可
+func pollardRhoBig(n *big.Int) (*big.Int, error) {
+	const batch = 128
+	one := big.NewInt(1)
+	for c := int64(1); c <= 20; c++ {
+		cc := big.NewInt(c)
+		next := func(x *big.Int) *big.Int {
+			x.Mul(x, x).Add(x, cc).Mod(x, n)
+			return x
+		}
+		y, x, ys := big.NewInt(2), new(big.Int), new(big.Int)
+		q, g := big.NewInt(1), big.NewInt(1)
+		diff := new(big.Int)
+		steps := 0
+		for r := 1; g.Cmp(one) == 0 && steps < rhoIterationLimit; r *= 2 {
+			x.Set(y)
+			for i := 0; i < r; i++ {
+				next(y)
+			}
+			for k := 0; k < r && g.Cmp(one) == 0; k += batch {
+				ys.Set(y)
+				for i := 0; i < min(batch, r-k); i++ {
+					next(y)
+					q.Mul(q, diff.Sub(x, y).Abs(diff)).Mod(q, n)
+				}
+				g.GCD(nil, nil, q, n)
+				steps += batch
+			}
+		}
+		if g.Cmp(n) == 0 {
+			// 批量累乘越过了因子，逐步回溯
+			for {
+				next(ys)
+				g.GCD(nil, nil, diff.Sub(x, ys).Abs(diff), n)
+				if g.Cmp(one) != 0 {
+					break
+				}
+			}
+		}
+		if g.Cmp(one) != 0 && g.Cmp(n) != 0 {
+			return g, nil
+		}
+	}
+	return nil, errors.New(errFactorLimit)
+}
+
+// gcdU 无符号整数的最大公约数
+func gcdU(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// Totient 欧拉函数：φ(n) = n·Π(1 - 1/p)
+func Totient(n uint64) (uint64, error) {
+	if n == 0 {
+		return 0, errors.New(errTotientZero)
+	}
+	factors, err := Factorize(n)
+	if err != nil {
+		return 0, err
+	}
+	result := n
+	for _, f := range factors {
+		result = result / f.Prime * (f.Prime - 1)
+	}
+	return result, nil
+}
+
+// Divisors 正整数的全部正因数（升序），由质因数分解组合得到
+func Divisors(n uint64) ([]uint64, error) {
+	factors, err := Factorize(n)
+	if err != nil {
+		return nil, err
+	}
+	divisors := []uint64{1}
+	for _, f := range factors {
+		current := len(divisors)
+		power := uint64(1)
+		for e := 0; e < f.Exponent; e++ {
+			power *= f.Prime
+			for i := 0; i < current; i++ {
+				divisors = append(divisors, divisors[i]*power)
+			}
+		}
+	}
+	sort.Slice(divisors, func(i, j int) bool { return divisors[i] < divisors[j] })
+	return divisors, nil
+}
@@ -0,0 +1,378 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package probability
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+var (
+	errTableShape    = "列联表至少为2×2且各行长度须相同"
+	errNegativeCount = "频数不得为负"
+	errEmptyMargin   = "列联表的行合计与列合计不得为0"
+	errDegrees       = "自由度须为正整数"
+	errAlpha         = "显著性水平须在(0, 1)内"
+)
+
+// CriticalValue 独立性检验临界值表中的一行：P(χ² ≥ Value) = Alpha
+type CriticalValue struct {
+	Alpha, Value float64
+}
+
+// ChiSquareCriticalValues 自由度为1时常用小概率值对应的临界值（教材表格）
+var ChiSquareCriticalValues = []CriticalValue{
+	{0.1, 2.706},
+	{0.05, 3.841},
+	{0.01, 6.635},
+	{0.005, 7.879},
+	{0.001, 10.828},
+}
+
+// ChiSquared 自由度为K的χ²分布
+type ChiSquared struct {
+	K int
+}
+
+// NewChiSquared 构造χ²分布
+func NewChiSquared(k int) (ChiSquared, error) {
+	if k <= 0 {
+		return ChiSquared{}, errors.New(errDegrees)
+	}
+	return ChiSquared{K: k}, nil
+}
+
+// PDF 密度 x^(k/2-1)e^(-x/2) / (2^(k/2)Γ(k/2))
+func (d ChiSquared) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	half := float64(d.K) / 2
+	if x == 0 {
+		switch {
+		case d.K == 1:
+			return math.Inf(1)
+		case d.K == 2:
+			return 0.5
+		}
+		return 0
+	}
+	lg, _ := math.Lgamma(half)
+	return math.Exp((half-1)*math.Log(x) - x/2 - half*math.Ln2 - lg)
+}
+
+// CDF P(χ² ≤ x)，即正则化下不完全伽马函数 P(k/2, x/2)
+func (d ChiSquared) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return regularizedGamma(float64(d.K)/2, x/2)
+}
+
+// Survival 上侧概率 P(χ² ≥ x)，即独立性检验的p值
+func (d ChiSquared) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return gammaQ(float64(d.K)/2, x/2)
+}
+
+// Quantile 分位数，二分求解 F(x) = p
+func (d ChiSquared) Quantile(p float64) (float64, error) {
+	if err := checkProbability(p); err != nil {
+		return 0, err
+	}
+	if p == 0 {
+		return 0, nil
+	}
+	if p == 1 {
+		return math.Inf(1), nil
+	}
+	lo, hi := 0.0, math.Max(1, float64(d.K))
+	for d.CDF(hi) < p {
+		lo, hi = hi, hi*2
+	}
+	for i := 0; i < 200 && hi-lo > 1e-12*math.Max(1, hi); i++ {
+		mid := (lo + hi) / 2
+		if d.CDF(mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2, nil
+}
+
+// Mean E(χ²) = k
+func (d ChiSquared) Mean() float64 {
+	return float64(d.K)
+}
+
+// Variance D(χ²) = 2k
+func (d ChiSquared) Variance() float64 {
+	return 2 * float64(d.K)
+}
+
+// Sample 抽样：k个独立标准正态变量的平方和
+func (d ChiSquared) Sample(r *rand.Rand) float64 {
+	sum := 0.0
+	for i := 0; i < d.K; i++ {
+		z := r.NormFloat64()
+		sum += z * z
+	}
+	return sum
+}
+
+// regularizedGamma 正则化下不完全伽马函数 P(a, x)
+// x < a+1 时用级数展开，否则用连分式求 Q = 1 - P
+func regularizedGamma(a, x float64) float64 {
+	if x < a+1 {
+		return gammaSeries(a, x)
+	}
+	return 1 - gammaQ(a, x)
+}
+
+func gammaSeries(a, x float64) float64 {
+	lg, _ := math.Lgamma(a)
+	term := 1 / a
+	sum := term
+	for n := 1; n < 1000; n++ {
+		term *= x / (a + float64(n))
+		sum += term
+		if math.Abs(term) < math.Abs(sum)*1e-16 {
+			break
+		}
+	}
+	return sum * math.Exp(-x+a*math.Log(x)-lg)
+}
+
+// gammaQ 正则化上不完全伽马函数 Q(a, x)，x ≥ a+1 时用Lentz连分式，否则由级数换算
+func gammaQ(a, x float64) float64 {
+	if x < a+1 {
+		return 1 - gammaSeries(a, x)
+	}
+	const tiny = 1e-300
+	lg, _ := math.Lgamma(a)
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i < 1000; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < 1e-16 {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lg) * h
+}
+
+// ChiSquareCritical 自由度为k、小概率值为α的临界值 xα，满足 P(χ² ≥ xα) = α
+func ChiSquareCritical(alpha float64, k int) (float64, error) {
+	if !(alpha > 0 && alpha < 1) {
+		return 0, errors.New(errAlpha)
+	}
+	d, err := NewChiSquared(k)
+	if err != nil {
+		return 0, err
+	}
+	return d.Quantile(1 - alpha)
+}
+
+// ContingencyTable r×c列联表，counts[i][j]为第i行第j列的频数
+type ContingencyTable struct {
+	counts [][]int
+}
+
+// NewContingencyTable 由频数构造列联表，各行各列的合计须为正
+func NewContingencyTable(counts [][]int) (ContingencyTable, error) {
+	if len(counts) < 2 || len(counts[0]) < 2 {
+		return ContingencyTable{}, errors.New(errTableShape)
+	}
+	t := ContingencyTable{counts: make([][]int, len(counts))}
+	for i, row := range counts {
+		if len(row) != len(counts[0]) {
+			return ContingencyTable{}, errors.New(errTableShape)
+		}
+		for _, v := range row {
+			if v < 0 {
+				return ContingencyTable{}, errors.New(errNegativeCount)
+			}
+		}
+		t.counts[i] = append([]int{}, row...)
+	}
+	for _, v := range t.RowTotals() {
+		if v == 0 {
+			return ContingencyTable{}, errors.New(errEmptyMargin)
+		}
+	}
+	for _, v := range t.ColumnTotals() {
+		if v == 0 {
+			return ContingencyTable{}, errors.New(errEmptyMargin)
+		}
+	}
+	return t, nil
+}
+
+// NewTable2x2 构造2×2列联表
+//
+//	     Y=0  Y=1
+//	X=0   a    b
+//	X=1   c    d
+func NewTable2x2(a, b, c, d int) (ContingencyTable, error) {
+	return NewContingencyTable([][]int{{a, b}, {c, d}})
+}
+
+// Counts 频数的副本
+func (t ContingencyTable) Counts() [][]int {
+	result := make([][]int, len(t.counts))
+	for i, row := range t.counts {
+		result[i] = append([]int{}, row...)
+	}
+	return result
+}
+
+// RowTotals 各行合计
+func (t ContingencyTable) RowTotals() []int {
+	totals := make([]int, len(t.counts))
+	for i, row := range t.counts {
+		for _, v := range row {
+			totals[i] += v
+		}
+	}
+	return totals
+}
+
+// ColumnTotals 各列合计
+func (t ContingencyTable) ColumnTotals() []int {
+	if len(t.counts) == 0 {
+		return nil
+	}
+	totals := make([]int, len(t.counts[0]))
+	for _, row := range t.counts {
+		for j, v := range row {
+			totals[j] += v
+		}
+	}
+	return totals
+}
+
+// Total 样本容量n
+func (t ContingencyTable) Total() int {
+	n := 0
+	for _, v := range t.RowTotals() {
+		n += v
+	}
+	return n
+}
+
+// Expected 独立假设下的期望频数 Eᵢⱼ = 行合计×列合计/n
+func (t ContingencyTable) Expected() [][]float64 {
+	rows, cols := t.RowTotals(), t.ColumnTotals()
+	n := float64(t.Total())
+	result := make([][]float64, len(rows))
+	for i := range rows {
+		result[i] = make([]float64, len(cols))
+		for j := range cols {
+			result[i][j] = float64(rows[i]) * float64(cols[j]) / n
+		}
+	}
+	return result
+}
+
+// DegreesOfFreedom 自由度 (r-1)(c-1)
+func (t ContingencyTable) DegreesOfFreedom() int {
+	if len(t.counts) == 0 {
+		return 0
+	}
+	return (len(t.counts) - 1) * (len(t.counts[0]) - 1)
+}
+
+// ChiSquare 统计量 χ² = Σ(Oᵢⱼ - Eᵢⱼ)²/Eᵢⱼ
+// 2×2表时等于 n(ad-bc)²/((a+b)(c+d)(a+c)(b+d))，此时直接按该式计算以避免舍入误差
+func (t ContingencyTable) ChiSquare() float64 {
+	if len(t.counts) == 2 && len(t.counts[0]) == 2 {
+		a, b := float64(t.counts[0][0]), float64(t.counts[0][1])
+		c, d := float64(t.counts[1][0]), float64(t.counts[1][1])
+		n := a + b + c + d
+		return n * (a*d - b*c) * (a*d - b*c) / ((a + b) * (c + d) * (a + c) * (b + d))
+	}
+	expected := t.Expected()
+	sum := 0.0
+	for i, row := range t.counts {
+		for j, o := range row {
+			diff := float64(o) - expected[i][j]
+			sum += diff * diff / expected[i][j]
+		}
+	}
+	return sum
+}
+
+// PValue p值 P(χ² ≥ 观测值)
+func (t ContingencyTable) PValue() float64 {
+	return ChiSquared{K: t.DegreesOfFreedom()}.Survival(t.ChiSquare())
+}
+
+// IndependenceTest 独立性检验的结论
+type IndependenceTest struct {
+	Statistic        float64
+	DegreesOfFreedom int
+	PValue           float64
+	Alpha            float64
+	Critical         float64
+	// Reject 为true表示 χ² ≥ xα，推断零假设H₀（两变量独立）不成立
+	Reject bool
+}
+
+// Test 在小概率值α下作独立性检验
+func (t ContingencyTable) Test(alpha float64) (IndependenceTest, error) {
+	df := t.DegreesOfFreedom()
+	critical, err := ChiSquareCritical(alpha, df)
+	if err != nil {
+		return IndependenceTest{}, err
+	}
+	if df == 1 {
+		// 与教材一致，常用α直接采用表中的三位小数临界值
+		for _, cv := range ChiSquareCriticalValues {
+			if cv.Alpha == alpha {
+				critical = cv.Value
+			}
+		}
+	}
+	stat := t.ChiSquare()
+	return IndependenceTest{
+		Statistic:        stat,
+		DegreesOfFreedom: df,
+		PValue:           t.PValue(),
+		Alpha:            alpha,
+		Critical:         critical,
+		Reject:           stat >= critical,
+	}, nil
+}
+
+// String 按教材格式给出检验结论
+func (r IndependenceTest) String() string {
+	if r.Reject {
+		return fmt.Sprintf("χ² = %.3f ≥ %.3f = x%g，根据小概率值α = %g的独立性检验，推断H₀不成立，即认为两变量有关联，此推断犯错误的概率不大于%g",
+			r.Statistic, r.Critical, r.Alpha, r.Alpha, r.Alpha)
+	}
+	return fmt.Sprintf("χ² = %.3f < %.3f = x%g，根据小概率值α = %g的独立性检验，没有充分证据推断H₀不成立，可以认为两变量独立",
+		r.Statistic, r.Critical, r.Alpha, r.Alpha)
+}
@@ -0,0 +1,340 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package probability
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	errTooFewSamples  = "样本个数不足"
+	errZeroVariance   = "样本方差为0，偏度与峰度无定义"
+	errNegativeWeight = "权重须为非负有限数"
+	errZeroWeight     = "权重之和须为正"
+	errClassInterval  = "分组须满足下限小于上限、按升序排列且互不重叠"
+	errNegativeFreq   = "频数须为非负数"
+)
+
+// Accumulator 流式统计量累加器（Welford算法），逐个加入数据，不保存原始样本
+// 零值即为空累加器；不同goroutine各自累加后可用Merge合并，单个累加器本身不是并发安全的
+type Accumulator struct {
+	n                int64
+	mean             float64
+	m2, m3, m4       float64 // 各阶中心矩之和 Σ(x-x̄)ᵏ
+	minimum, maximum float64
+}
+
+// Add 加入若干数据
+func (a *Accumulator) Add(xs ...float64) {
+	for _, x := range xs {
+		a.Merge(Accumulator{n: 1, mean: x, minimum: x, maximum: x})
+	}
+}
+
+// Merge 合并另一个累加器的数据（Chan–Pébay公式），结果与把两组数据依次加入相同
+func (a *Accumulator) Merge(b Accumulator) {
+	if b.n == 0 {
+		return
+	}
+	if a.n == 0 {
+		*a = b
+		return
+	}
+	na, nb := float64(a.n), float64(b.n)
+	n := na + nb
+	delta := b.mean - a.mean
+	d2 := delta * delta
+	m2 := a.m2 + b.m2 + d2*na*nb/n
+	m3 := a.m3 + b.m3 + d2*delta*na*nb*(na-nb)/(n*n) + 3*delta*(na*b.m2-nb*a.m2)/n
+	m4 := a.m4 + b.m4 + d2*d2*na*nb*(na*na-na*nb+nb*nb)/(n*n*n) +
+		6*d2*(na*na*b.m2+nb*nb*a.m2)/(n*n) + 4*delta*(na*b.m3-nb*a.m3)/n
+	a.n += b.n
+	a.mean += delta * nb / n
+	a.m2, a.m3, a.m4 = m2, m3, m4
+	a.minimum = math.Min(a.minimum, b.minimum)
+	a.maximum = math.Max(a.maximum, b.maximum)
+}
+
+// Count 数据个数
+func (a *Accumulator) Count() int64 {
+	return a.n
+}
+
+func (a *Accumulator) require(n int64) error {
+	if a.n == 0 {
+		return errors.New(dntExist)
+	}
+	if a.n < n {
+		return errors.New(errTooFewSamples)
+	}
+	return nil
+}
+
+// Mean 平均数
+func (a *Accumulator) Mean() (float64, error) {
+	if err := a.require(1); err != nil {
+		return 0, err
+	}
+	return a.mean, nil
+}
+
+// Min 最小值
+func (a *Accumulator) Min() (float64, error) {
+	if err := a.require(1); err != nil {
+		return 0, err
+	}
+	return a.minimum, nil
+}
+
+// Max 最大值
+func (a *Accumulator) Max() (float64, error) {
+	if err := a.require(1); err != nil {
+		return 0, err
+	}
+	return a.maximum, nil
+}
+
+// PopulationVariance 方差 s² = Σ(xᵢ-x̄)²/n
+func (a *Accumulator) PopulationVariance() (float64, error) {
+	if err := a.require(1); err != nil {
+		return 0, err
+	}
+	return a.m2 / float64(a.n), nil
+}
+
+// SampleVariance 无偏方差 Σ(xᵢ-x̄)²/(n-1)
+func (a *Accumulator) SampleVariance() (float64, error) {
+	if err := a.require(2); err != nil {
+		return 0, err
+	}
+	return a.m2 / float64(a.n-1), nil
+}
+
+// PopulationStdDev 标准差 √(Σ(xᵢ-x̄)²/n)
+func (a *Accumulator) PopulationStdDev() (float64, error) {
+	v, err := a.PopulationVariance()
+	return math.Sqrt(v), err
+}
+
+// SampleStdDev 无偏方差的算术平方根
+func (a *Accumulator) SampleStdDev() (float64, error) {
+	v, err := a.SampleVariance()
+	return math.Sqrt(v), err
+}
+
+// Skewness 偏度 g₁ = m₃/m₂^(3/2)，mₖ为k阶样本中心矩
+func (a *Accumulator) Skewness() (float64, error) {
+	if err := a.require(2); err != nil {
+		return 0, err
+	}
+	if a.m2 == 0 {
+		return 0, errors.New(errZeroVariance)
+	}
+	n := float64(a.n)
+	return math.Sqrt(n) * a.m3 / math.Pow(a.m2, 1.5), nil
+}
+
+// Kurtosis 超额峰度 g₂ = m₄/m₂² - 3，正态分布为0
+func (a *Accumulator) Kurtosis() (float64, error) {
+	if err := a.require(2); err != nil {
+		return 0, err
+	}
+	if a.m2 == 0 {
+		return 0, errors.New(errZeroVariance)
+	}
+	n := float64(a.n)
+	return n*a.m4/(a.m2*a.m2) - 3, nil
+}
+
+// WeightedAccumulator 带权数据的流式累加器（West算法），权重按频数理解
+// 零值即为空累加器，可用Merge合并
+type WeightedAccumulator struct {
+	weight float64
+	mean   float64
+	s      float64 // Σwᵢ(xᵢ-x̄)²
+}
+
+// Add 加入一个权重为w的数据，w = 0 时忽略
+func (a *WeightedAccumulator) Add(x, w float64) error {
+	if !(w >= 0) || math.IsInf(w, 1) {
+		return errors.New(errNegativeWeight)
+	}
+	if w == 0 {
+		return nil
+	}
+	a.Merge(WeightedAccumulator{weight: w, mean: x})
+	return nil
+}
+
+// Merge 合并另一个带权累加器
+func (a *WeightedAccumulator) Merge(b WeightedAccumulator) {
+	if b.weight == 0 {
+		return
+	}
+	if a.weight == 0 {
+		*a = b
+		return
+	}
+	w := a.weight + b.weight
+	delta := b.mean - a.mean
+	a.s += b.s + delta*delta*a.weight*b.weight/w
+	a.mean += delta * b.weight / w
+	a.weight = w
+}
+
+// TotalWeight 权重之和
+func (a *WeightedAccumulator) TotalWeight() float64 {
+	return a.weight
+}
+
+// Mean 加权平均数 Σwᵢxᵢ/Σwᵢ
+func (a *WeightedAccumulator) Mean() (float64, error) {
+	if a.weight == 0 {
+		return 0, errors.New(errZeroWeight)
+	}
+	return a.mean, nil
+}
+
+// PopulationVariance 加权方差 Σwᵢ(xᵢ-x̄)²/Σwᵢ
+func (a *WeightedAccumulator) PopulationVariance() (float64, error) {
+	if a.weight == 0 {
+		return 0, errors.New(errZeroWeight)
+	}
+	return a.s / a.weight, nil
+}
+
+// SampleVariance 以权重为频数的无偏方差 Σwᵢ(xᵢ-x̄)²/(Σwᵢ-1)
+func (a *WeightedAccumulator) SampleVariance() (float64, error) {
+	if a.weight <= 1 {
+		return 0, errors.New(errTooFewSamples)
+	}
+	return a.s / (a.weight - 1), nil
+}
+
+func weighted(values, weights []float64) (*WeightedAccumulator, error) {
+	if len(values) != len(weights) {
+		return nil, errors.New(errTableLength)
+	}
+	var a WeightedAccumulator
+	for i, x := range values {
+		if err := a.Add(x, weights[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &a, nil
+}
+
+// WeightedMean 加权平均数
+func WeightedMean(values, weights []float64) (float64, error) {
+	a, err := weighted(values, weights)
+	if err != nil {
+		return 0, err
+	}
+	return a.Mean()
+}
+
+// WeightedVariance 加权方差 Σwᵢ(xᵢ-x̄)²/Σwᵢ
+func WeightedVariance(values, weights []float64) (float64, error) {
+	a, err := weighted(values, weights)
+	if err != nil {
+		return 0, err
+	}
+	return a.PopulationVariance()
+}
+
+// ClassInterval 频数分布表中的一组 [Lower, Upper) 及其频数
+type ClassInterval struct {
+	Lower, Upper float64
+	Frequency    float64
+}
+
+// Midpoint 组中值
+func (c ClassInterval) Midpoint() float64 {
+	return (c.Lower + c.Upper) / 2
+}
+
+// Width 组距
+func (c ClassInterval) Width() float64 {
+	return c.Upper - c.Lower
+}
+
+// checkClasses 校验分组并返回总频数
+func checkClasses(classes []ClassInterval) (float64, error) {
+	if len(classes) == 0 {
+		return 0, errors.New(dntExist)
+	}
+	total := 0.0
+	for i, c := range classes {
+		if !(c.Lower < c.Upper) || (i > 0 && c.Lower < classes[i-1].Upper) {
+			return 0, errors.New(errClassInterval)
+		}
+		if !(c.Frequency >= 0) {
+			return 0, errors.New(errNegativeFreq)
+		}
+		total += c.Frequency
+	}
+	if total == 0 {
+		return 0, errors.New(errZeroWeight)
+	}
+	return total, nil
+}
+
+func groupedAccumulator(classes []ClassInterval) (*WeightedAccumulator, error) {
+	if _, err := checkClasses(classes); err != nil {
+		return nil, err
+	}
+	var a WeightedAccumulator
+	for _, c := range classes {
+		_ = a.Add(c.Midpoint(), c.Frequency)
+	}
+	return &a, nil
+}
+
+// GroupedMean 由频数分布表估计平均数：以组中值代表各组数据 Σfᵢmᵢ/Σfᵢ
+func GroupedMean(classes []ClassInterval) (float64, error) {
+	a, err := groupedAccumulator(classes)
+	if err != nil {
+		return 0, err
+	}
+	return a.Mean()
+}
+
+// GroupedVariance 由频数分布表估计方差 Σfᵢ(mᵢ-x̄)²/Σfᵢ
+func GroupedVariance(classes []ClassInterval) (float64, error) {
+	a, err := groupedAccumulator(classes)
+	if err != nil {
+		return 0, err
+	}
+	return a.PopulationVariance()
+}
+
+// GroupedPercentile 由频数分布表估计第p百分位数：假定组内数据均匀分布
+// 所在组为累计频率首次达到p%的组，x = L + (n·p% - F)/f × 组距
+func GroupedPercentile(p float64, classes []ClassInterval) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, errors.New(errPercentileRange)
+	}
+	total, err := checkClasses(classes)
+	if err != nil {
+		return 0, err
+	}
+	target := total * p / 100
+	cumulative := 0.0
+	for _, c := range classes {
+		if c.Frequency > 0 && cumulative+c.Frequency >= target {
+			return c.Lower + (target-cumulative)/c.Frequency*c.Width(), nil
+		}
+		cumulative += c.Frequency
+	}
+	return classes[len(classes)-1].Upper, nil
+}
+
+// GroupedMedian 由频数分布表估计中位数
+func GroupedMedian(classes []ClassInterval) (float64, error) {
+	return GroupedPercentile(50, classes)
+}
@@ -0,0 +1,314 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package probability
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	errBinWidth    = "组距须为正数"
+	errBinStart    = "起点不得大于样本最小值"
+	errBinningRule = "未知的分组规则"
+	errTooManyBins = "组数过多"
+	errBinTooFine  = "组距相对数据的量级过小，浮点数无法区分组界"
+)
+
+const maxBins = 1 << 16
+
+// BinningRule 由数据自动确定组数与组距的规则
+type BinningRule int
+
+const (
+	// BinSturges 斯特杰斯公式：组数 k = ⌈log₂n⌉ + 1
+	BinSturges BinningRule = iota
+	// BinFreedmanDiaconis 组距 h = 2·IQR·n^(-1/3)，对偏态和含异常值的数据更稳健；IQR为0时退化为斯特杰斯公式
+	BinFreedmanDiaconis
+)
+
+// FrequencyRow 频数分布表的一行
+type FrequencyRow struct {
+	ClassInterval
+	// Relative 频率 fᵢ/n
+	Relative float64
+	// Cumulative 累计频数
+	Cumulative float64
+	// CumulativeRelative 累计频率
+	CumulativeRelative float64
+	// Density 频率/组距，即频率分布直方图中矩形的高
+	Density float64
+}
+
+// FrequencyTable 频数分布表，各组为左闭右开区间，最后一组包含右端点
+type FrequencyTable struct {
+	classes []ClassInterval
+	total   float64
+}
+
+// NewFrequencyTable 按规则从原始数据生成频数分布表，起点为样本最小值
+// 数据中含有NaN或±Inf时返回错误
+func NewFrequencyTable(data []float64, rule BinningRule) (FrequencyTable, error) {
+	sorted, err := sortedCopy(data)
+	if err != nil {
+		return FrequencyTable{}, err
+	}
+	lo, hi := sorted[0], sorted[len(sorted)-1]
+	if lo == hi {
+		return binData(sorted, lo, 1, 1)
+	}
+	if math.IsInf(hi-lo, 0) {
+		return FrequencyTable{}, errors.New(errTooManyBins)
+	}
+	n := float64(len(sorted))
+	sturges := int(math.Ceil(math.Log2(n))) + 1
+	var k int
+	switch rule {
+	case BinSturges:
+		k = sturges
+	case BinFreedmanDiaconis:
+		q1, _ := sortedQuantile(sorted, 0.25, QuantileR7)
+		q3, _ := sortedQuantile(sorted, 0.75, QuantileR7)
+		k = sturges
+		if iqr := q3 - q1; iqr > 0 {
+			h := 2 * iqr / math.Cbrt(n)
+			k = int(math.Ceil((hi - lo) / h))
+		}
+	default:
+		return FrequencyTable{}, errors.New(errBinningRule)
+	}
+	if k > maxBins {
+		return FrequencyTable{}, errors.New(errTooManyBins)
+	}
+	return binData(sorted, lo, (hi-lo)/float64(k), k)
+}
+
+// NewFixedWidthTable 从start起以固定组距分组，组数取恰好覆盖最大值所需的个数
+func NewFixedWidthTable(data []float64, start, width float64) (FrequencyTable, error) {
+	if !(width > 0) || math.IsInf(width, 1) {
+		return FrequencyTable{}, errors.New(errBinWidth)
+	}
+	sorted, err := sortedCopy(data)
+	if err != nil {
+		return FrequencyTable{}, err
+	}
+	if start > sorted[0] {
+		return FrequencyTable{}, errors.New(errBinStart)
+	}
+	// 最后一组为闭区间，最大值恰在组界上时不再多开一组
+	span := (sorted[len(sorted)-1] - start) / width
+	if span >= maxBins {
+		return FrequencyTable{}, errors.New(errTooManyBins)
+	}
+	return binData(sorted, start, width, max(int(math.Ceil(span-1e-9)), 1))
+}
+
+// NewFrequencyTableFromClasses 由已分好的组构造频数分布表
+func NewFrequencyTableFromClasses(classes []ClassInterval) (FrequencyTable, error) {
+	total, err := checkClasses(classes)
+	if err != nil {
+		return FrequencyTable{}, err
+	}
+	return FrequencyTable{classes: append([]ClassInterval{}, classes...), total: total}, nil
+}
+
+// binData 把已排序数据分入k个等宽组，超出最后一组右端点的舍入误差计入最后一组
+func binData(sorted []float64, start, width float64, k int) (FrequencyTable, error) {
+	t := FrequencyTable{classes: make([]ClassInterval, k), total: float64(len(sorted))}
+	for i := range t.classes {
+		t.classes[i].Lower = boundary(start, width, i)
+		t.classes[i].Upper = boundary(start, width, i+1)
+		if !(t.classes[i].Lower < t.classes[i].Upper) {
+			return FrequencyTable{}, errors.New(errBinTooFine)
+		}
+	}
+	for _, x := range sorted {
+		i := int(math.Floor((x - start) / width))
+		i = min(max(i, 0), k-1)
+		// 组界上的数据按左闭右开归入后一组，补偿除法的舍入
+		if i+1 < k && x >= t.classes[i+1].Lower {
+			i++
+		} else if i > 0 && x < t.classes[i].Lower {
+			i--
+		}
+		t.classes[i].Frequency++
+	}
+	return t, nil
+}
+
+// boundary 第i个组界 start + i·width，按组距的量级保留小数位以舍去浮点尾差，使 0.1 + 2×0.1 得到 0.3
+// 保留到组距的十亿分之一，不会使相邻组界重合
+func boundary(start, width float64, i int) float64 {
+	v := start + float64(i)*width
+	decimals := max(0, int(math.Ceil(-math.Log10(width)))+9)
+	if decimals > 300 {
+		return v
+	}
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(v, 'f', decimals, 64), 64)
+	if err != nil {
+		return v
+	}
+	return rounded
+}
+
+// Classes 各组及其频数
+func (t FrequencyTable) Classes() []ClassInterval {
+	return append([]ClassInterval{}, t.classes...)
+}
+
+// Total 样本容量
+func (t FrequencyTable) Total() float64 {
+	return t.total
+}
+
+// Rows 频率、累计频率与频率/组距各列
+func (t FrequencyTable) Rows() []FrequencyRow {
+	rows := make([]FrequencyRow, len(t.classes))
+	cumulative := 0.0
+	for i, c := range t.classes {
+		cumulative += c.Frequency
+		rows[i] = FrequencyRow{
+			ClassInterval:      c,
+			Relative:           c.Frequency / t.total,
+			Cumulative:         cumulative,
+			CumulativeRelative: cumulative / t.total,
+			Density:            c.Frequency / (t.total * c.Width()),
+		}
+	}
+	return rows
+}
+
+// Mean 由直方图估计平均数：各组中值乘以频率之和
+func (t FrequencyTable) Mean() (float64, error) {
+	return GroupedMean(t.classes)
+}
+
+// Median 由直方图估计中位数：使左右两侧矩形面积相等的横坐标
+func (t FrequencyTable) Median() (float64, error) {
+	return GroupedMedian(t.classes)
+}
+
+// Modes 由直方图估计众数：最高矩形底边的中点，并列最高时全部返回
+func (t FrequencyTable) Modes() []float64 {
+	best := 0.0
+	var modes []float64
+	for _, r := range t.Rows() {
+		switch {
+		case r.Density > best:
+			best = r.Density
+			modes = []float64{r.Midpoint()}
+		case r.Density == best && best > 0:
+			modes = append(modes, r.Midpoint())
+		}
+	}
+	return modes
+}
+
+// label 组的区间记号，最后一组为闭区间
+func (t FrequencyTable) label(i int) string {
+	c := t.classes[i]
+	right := ")"
+	if i == len(t.classes)-1 {
+		right = "]"
+	}
+	exact := func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+	return "[" + exact(c.Lower) + ", " + exact(c.Upper) + right
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
+
+// String 以对齐的文本表格输出频数分布表
+func (t FrequencyTable) String() string {
+	table := [][]string{{"分组", "频数", "频率", "累计频率", "频率/组距"}}
+	for i, r := range t.Rows() {
+		table = append(table, []string{
+			t.label(i),
+			formatNumber(r.Frequency),
+			formatNumber(r.Relative),
+			formatNumber(r.CumulativeRelative),
+			formatNumber(r.Density),
+		})
+	}
+	table = append(table, []string{"合计", formatNumber(t.total), "1", "", ""})
+	widths := make([]int, len(table[0]))
+	for _, row := range table {
+		for j, cell := range row {
+			widths[j] = max(widths[j], displayWidth(cell))
+		}
+	}
+	var sb strings.Builder
+	for i, row := range table {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		line := ""
+		for j, cell := range row {
+			if j > 0 {
+				line += " | "
+			}
+			line += cell + strings.Repeat(" ", widths[j]-displayWidth(cell))
+		}
+		sb.WriteString(strings.TrimRight(line, " "))
+	}
+	return sb.String()
+}
+
+// displayWidth 终端显示宽度，中日韩字符占两列
+func displayWidth(s string) int {
+	w := 0
+	for _, r := range s {
+		if r >= 0x2E80 {
+			w += 2
+		} else {
+			w++
+		}
+	}
+	return w
+}
+
+// CSV 以CSV格式输出，数值保留全部精度
+func (t FrequencyTable) CSV() string {
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+	_ = w.Write([]string{"lower", "upper", "frequency", "relative", "cumulative", "cumulative_relative", "density"})
+	format := func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+	for _, r := range t.Rows() {
+		_ = w.Write([]string{
+			format(r.Lower), format(r.Upper), format(r.Frequency), format(r.Relative),
+			format(r.Cumulative), format(r.CumulativeRelative), format(r.Density),
+		})
+	}
+	w.Flush()
+	return sb.String()
+}
+
+// Histogram 以横向条形图输出频率分布直方图，最高的矩形占width个字符
+func (t FrequencyTable) Histogram(width int) string {
+	rows := t.Rows()
+	best := 0.0
+	labelWidth := 0
+	for i, r := range rows {
+		best = math.Max(best, r.Density)
+		labelWidth = max(labelWidth, len(t.label(i)))
+	}
+	var sb strings.Builder
+	for i, r := range rows {
+		bar := 0
+		if best > 0 {
+			bar = int(math.Round(r.Density / best * float64(max(width, 1))))
+		}
+		label := t.label(i)
+		fmt.Fprintf(&sb, "%s%s | %s %s\n", label, strings.Repeat(" ", labelWidth-len(label)), strings.Repeat("█", bar), formatNumber(r.Frequency))
+	}
+	return sb.String()
+}
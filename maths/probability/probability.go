@@ -12,3 +12,392 @@
 //"贝叶斯公式"
 
 package probability
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	errEmptySpace       = "样本空间不得为空"
+	errDuplicateOutcome = "样本点不得重复"
+	errWeight           = "样本点的权重须为非负数且总和为正"
+	errUnknownOutcome   = "样本点不属于该样本空间"
+	errZeroCondition    = "作为条件的事件概率须为正"
+	errNotPartition     = "事件组须两两互斥且并为样本空间"
+	errPriorSum         = "先验概率须非负且和为1"
+	errPartitionIndex   = "下标超出完备事件组的范围"
+	errSpaceMismatch    = "事件须属于同一样本空间"
+)
+
+// SampleSpace 有限样本空间，每个样本点带有精确的有理数概率
+type SampleSpace[T comparable] struct {
+	outcomes []T
+	probs    []*big.Rat
+	index    map[T]int
+}
+
+// NewSampleSpace 古典概型：各样本点等可能
+func NewSampleSpace[T comparable](outcomes ...T) (*SampleSpace[T], error) {
+	weights := make([]*big.Rat, len(outcomes))
+	for i := range weights {
+		weights[i] = big.NewRat(1, 1)
+	}
+	return NewWeightedSampleSpace(outcomes, weights)
+}
+
+// NewWeightedSampleSpace 各样本点按权重分配概率，P(ω) = 权重/权重总和
+func NewWeightedSampleSpace[T comparable](outcomes []T, weights []*big.Rat) (*SampleSpace[T], error) {
+	if len(outcomes) == 0 {
+		return nil, errors.New(errEmptySpace)
+	}
+	if len(outcomes) != len(weights) {
+		return nil, errors.New(errTableLength)
+	}
+	total := new(big.Rat)
+	for _, w := range weights {
+		if w == nil || w.Sign() < 0 {
+			return nil, errors.New(errWeight)
+		}
+		total.Add(total, w)
+	}
+	if total.Sign() == 0 {
+		return nil, errors.New(errWeight)
+	}
+	s := &SampleSpace[T]{index: make(map[T]int, len(outcomes))}
+	for i, o := range outcomes {
+		if _, ok := s.index[o]; ok {
+			return nil, errors.New(errDuplicateOutcome)
+		}
+		s.index[o] = i
+		s.outcomes = append(s.outcomes, o)
+		s.probs = append(s.probs, new(big.Rat).Quo(weights[i], total))
+	}
+	return s, nil
+}
+
+// Pair 两次试验组成的复合试验的样本点
+type Pair[A, B comparable] struct {
+	First  A
+	Second B
+}
+
+// ProductSpace 两个独立试验的积样本空间，P((a, b)) = P(a)P(b)
+func ProductSpace[A, B comparable](a *SampleSpace[A], b *SampleSpace[B]) *SampleSpace[Pair[A, B]] {
+	s := &SampleSpace[Pair[A, B]]{index: make(map[Pair[A, B]]int, len(a.outcomes)*len(b.outcomes))}
+	for i, x := range a.outcomes {
+		for j, y := range b.outcomes {
+			p := Pair[A, B]{x, y}
+			s.index[p] = len(s.outcomes)
+			s.outcomes = append(s.outcomes, p)
+			s.probs = append(s.probs, new(big.Rat).Mul(a.probs[i], b.probs[j]))
+		}
+	}
+	return s
+}
+
+// Outcomes 全部样本点
+func (s *SampleSpace[T]) Outcomes() []T {
+	return append([]T{}, s.outcomes...)
+}
+
+// ProbabilityOf 单个样本点的概率
+func (s *SampleSpace[T]) ProbabilityOf(outcome T) (*big.Rat, error) {
+	i, ok := s.index[outcome]
+	if !ok {
+		return nil, errors.New(errUnknownOutcome)
+	}
+	return new(big.Rat).Set(s.probs[i]), nil
+}
+
+// Event 样本空间的子集
+type Event[T comparable] struct {
+	space   *SampleSpace[T]
+	members []bool
+}
+
+// Where 满足谓词的样本点组成的事件
+func (s *SampleSpace[T]) Where(pred func(T) bool) Event[T] {
+	e := Event[T]{space: s, members: make([]bool, len(s.outcomes))}
+	for i, o := range s.outcomes {
+		e.members[i] = pred(o)
+	}
+	return e
+}
+
+// EventOf 由列出的样本点组成的事件
+func (s *SampleSpace[T]) EventOf(outcomes ...T) (Event[T], error) {
+	e := Event[T]{space: s, members: make([]bool, len(s.outcomes))}
+	for _, o := range outcomes {
+		i, ok := s.index[o]
+		if !ok {
+			return Event[T]{}, errors.New(errUnknownOutcome)
+		}
+		e.members[i] = true
+	}
+	return e, nil
+}
+
+// Certain 必然事件Ω
+func (s *SampleSpace[T]) Certain() Event[T] {
+	return s.Where(func(T) bool { return true })
+}
+
+// Impossible 不可能事件∅
+func (s *SampleSpace[T]) Impossible() Event[T] {
+	return s.Where(func(T) bool { return false })
+}
+
+// combine 逐样本点合成事件，两事件须属于同一样本空间
+func (e Event[T]) combine(f Event[T], op func(a, b bool) bool) (Event[T], error) {
+	if e.space == nil || e.space != f.space {
+		return Event[T]{}, errors.New(errSpaceMismatch)
+	}
+	result := Event[T]{space: e.space, members: make([]bool, len(e.members))}
+	for i := range e.members {
+		result.members[i] = op(e.members[i], f.members[i])
+	}
+	return result, nil
+}
+
+// Union 和事件 A∪B
+func (e Event[T]) Union(f Event[T]) (Event[T], error) {
+	return e.combine(f, func(a, b bool) bool { return a || b })
+}
+
+// Intersect 积事件 A∩B
+func (e Event[T]) Intersect(f Event[T]) (Event[T], error) {
+	return e.combine(f, func(a, b bool) bool { return a && b })
+}
+
+// Difference 差事件 A - B = A∩B̄
+func (e Event[T]) Difference(f Event[T]) (Event[T], error) {
+	return e.combine(f, func(a, b bool) bool { return a && !b })
+}
+
+// Complement 对立事件Ā
+func (e Event[T]) Complement() Event[T] {
+	result := Event[T]{space: e.space, members: make([]bool, len(e.members))}
+	for i, in := range e.members {
+		result.members[i] = !in
+	}
+	return result
+}
+
+// Outcomes 事件包含的样本点
+func (e Event[T]) Outcomes() []T {
+	var result []T
+	for i, in := range e.members {
+		if in {
+			result = append(result, e.space.outcomes[i])
+		}
+	}
+	return result
+}
+
+// Len 事件包含的样本点个数
+func (e Event[T]) Len() int {
+	n := 0
+	for _, in := range e.members {
+		if in {
+			n++
+		}
+	}
+	return n
+}
+
+// Contains 样本点是否属于事件
+func (e Event[T]) Contains(outcome T) bool {
+	if e.space == nil {
+		return false
+	}
+	i, ok := e.space.index[outcome]
+	return ok && e.members[i]
+}
+
+// IsEmpty 是否为不可能事件
+func (e Event[T]) IsEmpty() bool {
+	return e.Len() == 0
+}
+
+// MutuallyExclusive 两事件是否互斥，即 A∩B = ∅
+func (e Event[T]) MutuallyExclusive(f Event[T]) (bool, error) {
+	joint, err := e.Intersect(f)
+	if err != nil {
+		return false, err
+	}
+	return joint.IsEmpty(), nil
+}
+
+// P 事件的概率，古典概型下为 n(A)/n(Ω)
+func (e Event[T]) P() *big.Rat {
+	sum := new(big.Rat)
+	for i, in := range e.members {
+		if in {
+			sum.Add(sum, e.space.probs[i])
+		}
+	}
+	return sum
+}
+
+// String 以集合形式列出样本点
+func (e Event[T]) String() string {
+	parts := make([]string, 0, len(e.members))
+	for _, o := range e.Outcomes() {
+		parts = append(parts, fmt.Sprint(o))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// Conditional 条件概率 P(A|B) = P(AB)/P(B)
+func Conditional[T comparable](a, b Event[T]) (*big.Rat, error) {
+	joint, err := a.Intersect(b)
+	if err != nil {
+		return nil, err
+	}
+	pb := b.P()
+	if pb.Sign() == 0 {
+		return nil, errors.New(errZeroCondition)
+	}
+	return new(big.Rat).Quo(joint.P(), pb), nil
+}
+
+// Independent 两事件相互独立 ⇔ P(AB) = P(A)P(B)，按有理数精确判断
+func Independent[T comparable](a, b Event[T]) (bool, error) {
+	joint, err := a.Intersect(b)
+	if err != nil {
+		return false, err
+	}
+	return joint.P().Cmp(new(big.Rat).Mul(a.P(), b.P())) == 0, nil
+}
+
+// MutuallyIndependent 多个事件相互独立：其中任意k（k ≥ 2）个事件之积的概率等于各自概率之积
+func MutuallyIndependent[T comparable](events ...Event[T]) (bool, error) {
+	for _, e := range events {
+		if e.space == nil || e.space != events[0].space {
+			return false, errors.New(errSpaceMismatch)
+		}
+	}
+	for mask := 1; mask < 1<<len(events); mask++ {
+		if mask&(mask-1) == 0 {
+			continue
+		}
+		var joint Event[T]
+		product := big.NewRat(1, 1)
+		first := true
+		for i, e := range events {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			if first {
+				joint, first = e, false
+			} else {
+				joint, _ = joint.Intersect(e) // 上面已校验同属一个样本空间
+			}
+			product.Mul(product, e.P())
+		}
+		if joint.P().Cmp(product) != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// checkPartition 校验事件组为完备事件组：两两互斥且并为Ω
+func checkPartition[T comparable](partition []Event[T]) error {
+	if len(partition) == 0 {
+		return errors.New(errNotPartition)
+	}
+	if partition[0].space == nil {
+		return errors.New(errSpaceMismatch)
+	}
+	covered := make([]int, len(partition[0].members))
+	for _, b := range partition {
+		if b.space != partition[0].space {
+			return errors.New(errSpaceMismatch)
+		}
+		for i, in := range b.members {
+			if in {
+				covered[i]++
+			}
+		}
+	}
+	for _, c := range covered {
+		if c != 1 {
+			return errors.New(errNotPartition)
+		}
+	}
+	return nil
+}
+
+// TotalProbability 全概率公式 P(A) = ΣP(Bᵢ)P(A|Bᵢ)，先验与似然直接以数值给出
+func TotalProbability(priors, likelihoods []*big.Rat) (*big.Rat, error) {
+	if len(priors) == 0 || len(priors) != len(likelihoods) {
+		return nil, errors.New(errTableLength)
+	}
+	sum := new(big.Rat)
+	for _, p := range priors {
+		if p == nil || p.Sign() < 0 {
+			return nil, errors.New(errPriorSum)
+		}
+		sum.Add(sum, p)
+	}
+	if sum.Cmp(big.NewRat(1, 1)) != 0 {
+		return nil, errors.New(errPriorSum)
+	}
+	total := new(big.Rat)
+	for i, p := range priors {
+		if likelihoods[i] == nil || likelihoods[i].Sign() < 0 || likelihoods[i].Cmp(big.NewRat(1, 1)) > 0 {
+			return nil, errors.New(errProbability)
+		}
+		total.Add(total, new(big.Rat).Mul(p, likelihoods[i]))
+	}
+	return total, nil
+}
+
+// Bayes 贝叶斯公式 P(Bᵢ|A) = P(Bᵢ)P(A|Bᵢ) / ΣP(Bⱼ)P(A|Bⱼ)，返回全部后验概率
+func Bayes(priors, likelihoods []*big.Rat) ([]*big.Rat, error) {
+	total, err := TotalProbability(priors, likelihoods)
+	if err != nil {
+		return nil, err
+	}
+	if total.Sign() == 0 {
+		return nil, errors.New(errZeroCondition)
+	}
+	posteriors := make([]*big.Rat, len(priors))
+	for i, p := range priors {
+		posteriors[i] = new(big.Rat).Mul(p, likelihoods[i])
+		posteriors[i].Quo(posteriors[i], total)
+	}
+	return posteriors, nil
+}
+
+// TotalProbabilityOver 在样本空间上验证全概率公式：partition须为完备事件组
+func TotalProbabilityOver[T comparable](partition []Event[T], a Event[T]) (*big.Rat, error) {
+	if err := checkPartition(partition); err != nil {
+		return nil, err
+	}
+	total := new(big.Rat)
+	for _, b := range partition {
+		// P(Bᵢ)P(A|Bᵢ) = P(ABᵢ)，P(Bᵢ) = 0 的项贡献为0
+		joint, err := a.Intersect(b)
+		if err != nil {
+			return nil, err
+		}
+		total.Add(total, joint.P())
+	}
+	return total, nil
+}
+
+// BayesOver 在样本空间上求后验概率 P(Bᵢ|A)
+func BayesOver[T comparable](partition []Event[T], a Event[T], i int) (*big.Rat, error) {
+	if i < 0 || i >= len(partition) {
+		return nil, errors.New(errPartitionIndex)
+	}
+	if err := checkPartition(partition); err != nil {
+		return nil, err
+	}
+	return Conditional(partition[i], a)
+}
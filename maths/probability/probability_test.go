@@ -0,0 +1,227 @@
+package probability
+
+import (
+	"math/big"
+	"testing"
+)
+
+type dice = Pair[int, int]
+
+// twoDice 掷两枚骰子的积样本空间
+func twoDice(t *testing.T) *SampleSpace[dice] {
+	t.Helper()
+	die, err := NewSampleSpace(1, 2, 3, 4, 5, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ProductSpace(die, die)
+}
+
+// countRatio 暴力枚举36种结果，返回满足 num 的个数与满足 den 的个数之比
+func countRatio(num, den func(dice) bool) *big.Rat {
+	hit, total := 0, 0
+	for a := 1; a <= 6; a++ {
+		for b := 1; b <= 6; b++ {
+			d := dice{a, b}
+			if !den(d) {
+				continue
+			}
+			total++
+			if num(d) {
+				hit++
+			}
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	return big.NewRat(int64(hit), int64(total))
+}
+
+var dicePredicates = map[string]func(dice) bool{
+	"和为7":    func(d dice) bool { return d.First+d.Second == 7 },
+	"和为8":    func(d dice) bool { return d.First+d.Second == 8 },
+	"第一枚为偶数": func(d dice) bool { return d.First%2 == 0 },
+	"第二枚为6":  func(d dice) bool { return d.Second == 6 },
+	"有一枚为1":  func(d dice) bool { return d.First == 1 || d.Second == 1 },
+	"两枚相同":   func(d dice) bool { return d.First == d.Second },
+	"不可能":    func(dice) bool { return false },
+}
+
+func always(dice) bool { return true }
+
+func TestEventProbabilityByEnumeration(t *testing.T) {
+	space := twoDice(t)
+	for name, a := range dicePredicates {
+		e := space.Where(a)
+		if want := countRatio(a, always); e.P().Cmp(want) != 0 {
+			t.Errorf("P(%s) = %v, 枚举得到 %v", name, e.P(), want)
+		}
+		if want := countRatio(func(d dice) bool { return !a(d) }, always); e.Complement().P().Cmp(want) != 0 {
+			t.Errorf("P(非%s) = %v, 枚举得到 %v", name, e.Complement().P(), want)
+		}
+		for other, b := range dicePredicates {
+			f := space.Where(b)
+			union, err := e.Union(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := countRatio(func(d dice) bool { return a(d) || b(d) }, always); union.P().Cmp(want) != 0 {
+				t.Errorf("P(%s∪%s) = %v, 枚举得到 %v", name, other, union.P(), want)
+			}
+			diff, err := e.Difference(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := countRatio(func(d dice) bool { return a(d) && !b(d) }, always); diff.P().Cmp(want) != 0 {
+				t.Errorf("P(%s-%s) = %v, 枚举得到 %v", name, other, diff.P(), want)
+			}
+		}
+	}
+}
+
+func TestConditionalAndIndependenceByEnumeration(t *testing.T) {
+	space := twoDice(t)
+	for name, a := range dicePredicates {
+		for given, b := range dicePredicates {
+			want := countRatio(a, b)
+			got, err := Conditional(space.Where(a), space.Where(b))
+			if want == nil {
+				if err == nil {
+					t.Errorf("P(%s|%s) 条件概率为0时应报错", name, given)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("P(%s|%s): %v", name, given, err)
+			}
+			if got.Cmp(want) != 0 {
+				t.Errorf("P(%s|%s) = %v, 枚举得到 %v", name, given, got, want)
+			}
+
+			// 独立 ⇔ n(AB)·n(Ω) = n(A)·n(B)
+			joint := countRatio(func(d dice) bool { return a(d) && b(d) }, always)
+			product := new(big.Rat).Mul(countRatio(a, always), countRatio(b, always))
+			independent, err := Independent(space.Where(a), space.Where(b))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if independent != (joint.Cmp(product) == 0) {
+				t.Errorf("Independent(%s, %s) = %v", name, given, independent)
+			}
+		}
+	}
+}
+
+func TestMutuallyIndependent(t *testing.T) {
+	space := twoDice(t)
+	// 经典反例：两两独立但不相互独立
+	firstEven := space.Where(func(d dice) bool { return d.First%2 == 0 })
+	secondEven := space.Where(func(d dice) bool { return d.Second%2 == 0 })
+	sumEven := space.Where(func(d dice) bool { return (d.First+d.Second)%2 == 0 })
+	for _, pair := range [][2]Event[dice]{{firstEven, secondEven}, {firstEven, sumEven}, {secondEven, sumEven}} {
+		if ok, err := Independent(pair[0], pair[1]); err != nil || !ok {
+			t.Errorf("两两独立判断错误：%v, %v", ok, err)
+		}
+	}
+	if ok, err := MutuallyIndependent(firstEven, secondEven, sumEven); err != nil || ok {
+		t.Errorf("MutuallyIndependent = %v, %v，应为false", ok, err)
+	}
+	secondSix := space.Where(func(d dice) bool { return d.Second == 6 })
+	firstOne := space.Where(func(d dice) bool { return d.First == 1 })
+	if ok, err := MutuallyIndependent(firstEven, secondSix, space.Certain()); err != nil || !ok {
+		t.Errorf("MutuallyIndependent = %v, %v，应为true", ok, err)
+	}
+	if ok, err := MutuallyIndependent(firstEven, firstOne); err != nil || ok {
+		t.Errorf("互斥的正概率事件不独立：%v, %v", ok, err)
+	}
+}
+
+func TestTotalProbabilityAndBayesByEnumeration(t *testing.T) {
+	// 三个盒子分别有 1、2、3 个红球和 3、2、1 个白球，先等可能选盒子再摸一球
+	type draw struct {
+		box  int
+		red  bool
+		ball int
+	}
+	var outcomes []draw
+	for box := range 3 {
+		for ball := range 4 {
+			outcomes = append(outcomes, draw{box, ball <= box, ball})
+		}
+	}
+	space, err := NewSampleSpace(outcomes...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	red := space.Where(func(d draw) bool { return d.red })
+	partition := make([]Event[draw], 3)
+	priors := make([]*big.Rat, 3)
+	likelihoods := make([]*big.Rat, 3)
+	for box := range 3 {
+		partition[box] = space.Where(func(d draw) bool { return d.box == box })
+		priors[box] = big.NewRat(1, 3)
+		likelihoods[box] = big.NewRat(int64(box+1), 4)
+	}
+
+	// 暴力计数：12个等可能结果中红球有6个
+	want := big.NewRat(6, 12)
+	total, err := TotalProbability(priors, likelihoods)
+	if err != nil || total.Cmp(want) != 0 {
+		t.Errorf("TotalProbability = %v, %v，枚举得到 %v", total, err, want)
+	}
+	over, err := TotalProbabilityOver(partition, red)
+	if err != nil || over.Cmp(want) != 0 {
+		t.Errorf("TotalProbabilityOver = %v, %v，枚举得到 %v", over, err, want)
+	}
+
+	posteriors, err := Bayes(priors, likelihoods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for box := range 3 {
+		// 红球中来自该盒子的个数 / 红球总数
+		want := big.NewRat(int64(box+1), 6)
+		if posteriors[box].Cmp(want) != 0 {
+			t.Errorf("Bayes[%d] = %v, 枚举得到 %v", box, posteriors[box], want)
+		}
+		got, err := BayesOver(partition, red, box)
+		if err != nil || got.Cmp(want) != 0 {
+			t.Errorf("BayesOver(%d) = %v, %v，枚举得到 %v", box, got, err, want)
+		}
+	}
+}
+
+func TestErrorsInsteadOfPanics(t *testing.T) {
+	space := twoDice(t)
+	other := twoDice(t)
+	a := space.Where(func(d dice) bool { return d.First == 1 })
+	b := other.Where(func(d dice) bool { return d.First == 1 })
+	var zero Event[dice]
+
+	if _, err := a.Union(b); err == nil {
+		t.Error("不同样本空间的事件求和事件应报错")
+	}
+	if _, err := Conditional(a, b); err == nil {
+		t.Error("Conditional 不同样本空间应报错")
+	}
+	if _, err := Independent(a, zero); err == nil {
+		t.Error("Independent 零值事件应报错")
+	}
+	if _, err := MutuallyIndependent(a, a, b); err == nil {
+		t.Error("MutuallyIndependent 不同样本空间应报错")
+	}
+	if _, err := TotalProbabilityOver([]Event[dice]{space.Certain()}, b); err == nil {
+		t.Error("TotalProbabilityOver 不同样本空间应报错")
+	}
+	if _, err := TotalProbabilityOver([]Event[dice]{zero}, a); err == nil {
+		t.Error("TotalProbabilityOver 零值事件组应报错")
+	}
+	half := big.NewRat(1, 2)
+	if _, err := TotalProbability([]*big.Rat{half, nil}, []*big.Rat{half, half}); err == nil {
+		t.Error("TotalProbability 先验为nil应报错")
+	}
+	if _, err := Bayes([]*big.Rat{half, half}, []*big.Rat{half, nil}); err == nil {
+		t.Error("Bayes 似然为nil应报错")
+	}
+}
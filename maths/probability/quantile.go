@@ -0,0 +1,202 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package probability
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+var (
+	errPercentileRange = "百分位数p须在[0, 100]内"
+	errQuantileMethod  = "未知的分位数定义"
+	errFenceFactor     = "异常值判定系数须为非负数"
+)
+
+// QuantileMethod 样本分位数的定义，编号与Hyndman–Fan分类（R语言 quantile 的type参数）一致
+type QuantileMethod int
+
+const (
+	// QuantileR1 经验分布函数的逆：取第⌈np⌉个数
+	QuantileR1 QuantileMethod = iota + 1
+	// QuantileR2 np为整数时取第np与第np+1个数的平均数，否则取第⌈np⌉个数
+	QuantileR2
+	// QuantileR3 取最接近np的数，恰在中间时取偶数位次（SAS定义）
+	QuantileR3
+	// QuantileR4 经验分布函数的线性插值，位次 np
+	QuantileR4
+	// QuantileR5 位次 np + 1/2 的线性插值
+	QuantileR5
+	// QuantileR6 位次 (n+1)p 的线性插值（Minitab、SPSS）
+	QuantileR6
+	// QuantileR7 位次 (n-1)p + 1 的线性插值（R、Excel、NumPy的默认定义）
+	QuantileR7
+	// QuantileR8 位次 (n+1/3)p + 1/3 的线性插值，近似中位数无偏
+	QuantileR8
+	// QuantileR9 位次 (n+1/4)p + 3/8 的线性插值，正态样本近似无偏
+	QuantileR9
+
+	// QuantileTextbook 教材定义：计算 i = n×p%，i不是整数时取第⌈i⌉项，是整数时取第i项与第i+1项的平均数，与R2相同
+	QuantileTextbook = QuantileR2
+)
+
+const quantileFuzz = 1e-12 // 判断位次是否为整数时容许的浮点误差
+
+// sortedCopy 返回升序排列的副本，不修改调用方的切片，拒绝NaN与±Inf
+func sortedCopy(data []float64) ([]float64, error) {
+	if len(data) == 0 {
+		return nil, errors.New(dntExist)
+	}
+	for _, v := range data {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, errors.New(errNonFiniteData)
+		}
+	}
+	sorted := slices.Clone(data)
+	slices.Sort(sorted)
+	return sorted, nil
+}
+
+// PercentileBy 按指定定义计算第p百分位数，p ∈ [0, 100]，不修改data
+func PercentileBy(p float64, data []float64, method QuantileMethod) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, errors.New(errPercentileRange)
+	}
+	sorted, err := sortedCopy(data)
+	if err != nil {
+		return 0, err
+	}
+	return sortedQuantile(sorted, p/100, method)
+}
+
+// sortedQuantile 在已排序样本上计算q分位数（q ∈ [0, 1]）
+// 统一写成 Q = (1-γ)x_j + γx_(j+1)，j = ⌊nq + m⌋，g = nq + m - j，位次越界时取端点
+func sortedQuantile(x []float64, q float64, method QuantileMethod) (float64, error) {
+	n := float64(len(x))
+	var m float64
+	switch method {
+	case QuantileR1, QuantileR2, QuantileR4:
+		m = 0
+	case QuantileR3:
+		m = -0.5
+	case QuantileR5:
+		m = 0.5
+	case QuantileR6:
+		m = q
+	case QuantileR7:
+		m = 1 - q
+	case QuantileR8:
+		m = (q + 1) / 3
+	case QuantileR9:
+		m = q/4 + 3.0/8
+	default:
+		return 0, errors.New(errQuantileMethod)
+	}
+	h := n*q + m
+	j := math.Floor(h + quantileFuzz)
+	g := h - j
+	if math.Abs(g) < quantileFuzz {
+		g = 0
+	}
+	var gamma float64
+	switch method {
+	case QuantileR1:
+		gamma = 1
+		if g == 0 {
+			gamma = 0
+		}
+	case QuantileR2:
+		gamma = 1
+		if g == 0 {
+			gamma = 0.5
+		}
+	case QuantileR3:
+		gamma = 1
+		if g == 0 && int(j)%2 == 0 {
+			gamma = 0
+		}
+	default:
+		gamma = g
+	}
+	at := func(k float64) float64 {
+		i := int(math.Min(math.Max(k, 1), n)) - 1
+		return x[i]
+	}
+	lo, hi := at(j), at(j+1)
+	if gamma == 0 {
+		return lo, nil
+	}
+	return (1-gamma)*lo + gamma*hi, nil
+}
+
+// Quantiles 一次计算多个百分位数，只排序一次
+func Quantiles(data []float64, method QuantileMethod, ps ...float64) ([]float64, error) {
+	sorted, err := sortedCopy(data)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]float64, len(ps))
+	for i, p := range ps {
+		if !(p >= 0 && p <= 100) {
+			return nil, errors.New(errPercentileRange)
+		}
+		if result[i], err = sortedQuantile(sorted, p/100, method); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Median 中位数，按教材定义：n为偶数时取中间两数的平均数
+// R1、R3等不插值的定义在n为偶数时取中间两数之一，需要时使用PercentileBy
+func Median(data []float64) (float64, error) {
+	return PercentileBy(50, data, QuantileTextbook)
+}
+
+// FiveNumberSummary 五数概括：最小值、第一四分位数、中位数、第三四分位数、最大值
+type FiveNumberSummary struct {
+	Min, Q1, Median, Q3, Max float64
+}
+
+// Summarize 按指定分位数定义计算五数概括
+func Summarize(data []float64, method QuantileMethod) (FiveNumberSummary, error) {
+	q, err := Quantiles(data, method, 0, 25, 50, 75, 100)
+	if err != nil {
+		return FiveNumberSummary{}, err
+	}
+	return FiveNumberSummary{Min: q[0], Q1: q[1], Median: q[2], Q3: q[3], Max: q[4]}, nil
+}
+
+// IQR 四分位距 Q3 - Q1
+func (s FiveNumberSummary) IQR() float64 {
+	return s.Q3 - s.Q1
+}
+
+// Fences 异常值界限 [Q1 - k·IQR, Q3 + k·IQR]，通常 k = 1.5，极端异常值取 k = 3
+func (s FiveNumberSummary) Fences(k float64) (lower, upper float64) {
+	return s.Q1 - k*s.IQR(), s.Q3 + k*s.IQR()
+}
+
+// Outliers 落在异常值界限之外的数据，按原顺序返回
+func Outliers(data []float64, k float64, method QuantileMethod) ([]float64, error) {
+	if !(k >= 0) {
+		return nil, errors.New(errFenceFactor)
+	}
+	s, err := Summarize(data, method)
+	if err != nil {
+		return nil, err
+	}
+	lower, upper := s.Fences(k)
+	var result []float64
+	for _, v := range data {
+		if v < lower || v > upper {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
@@ -10,3 +10,791 @@
 //"方差的性质"
 
 package probability
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	errProbability    = "概率须在[0, 1]内"
+	errTrials         = "试验次数须为非负整数"
+	errHypergeometric = "超几何分布须满足 0 ≤ M ≤ N，0 ≤ n ≤ N"
+	errGeometric      = "几何分布的成功概率须在(0, 1]内"
+	errRate           = "参数须为正数"
+	errUniformBounds  = "均匀分布须满足 a < b"
+	errTableLength    = "取值与概率的个数须相同且非空"
+	errProbabilitySum = "分布列的概率之和须为1"
+)
+
+const probabilitySumTolerance = 1e-9
+
+// Distribution 一维概率分布的公共接口
+type Distribution interface {
+	// CDF 分布函数 F(x) = P(X ≤ x)
+	CDF(x float64) float64
+	// Quantile 分位数：满足 F(x) ≥ p 的最小x
+	Quantile(p float64) (float64, error)
+	// Mean 期望 E(X)
+	Mean() float64
+	// Variance 方差 D(X)
+	Variance() float64
+	// Sample 用给定随机源抽取一个样本
+	Sample(r *rand.Rand) float64
+}
+
+// DiscreteDistribution 取整数值的离散型分布
+type DiscreteDistribution interface {
+	Distribution
+	// PMF 分布列 P(X = k)
+	PMF(k int) float64
+}
+
+// ContinuousDistribution 连续型分布
+type ContinuousDistribution interface {
+	Distribution
+	// PDF 概率密度 f(x)
+	PDF(x float64) float64
+}
+
+func checkProbability(p float64) error {
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return errors.New(errProbability)
+	}
+	return nil
+}
+
+const exactChooseLimit = 1000 // n不超过此值时逐项连乘计算组合数，精度优于对数伽马函数
+
+// choose 组合数 C(n, k) 的浮点值
+func choose(n, k int) float64 {
+	if n > exactChooseLimit {
+		return math.Exp(logChoose(n, k))
+	}
+	k = min(k, n-k)
+	c := 1.0
+	for i := 1; i <= k; i++ {
+		c = c * float64(n-k+i) / float64(i)
+	}
+	return c
+}
+
+// logChoose ln C(n, k)，用对数伽马函数避免大n时溢出
+func logChoose(n, k int) float64 {
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+	return a - b - c
+}
+
+// discreteCDF 在支撑集 [lo, hi] 上逐项累加分布列，hi < 0 表示无上界
+// 无上界时越过期望后分布列的项小到可以忽略即停止累加
+func discreteCDF(d DiscreteDistribution, lo, hi int, x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x < float64(lo):
+		return 0
+	case math.IsInf(x, 1) || (hi >= 0 && x >= float64(hi)):
+		return 1
+	}
+	last := math.Floor(x)
+	if hi >= 0 {
+		last = math.Min(last, float64(hi))
+	}
+	mean := d.Mean()
+	sum := 0.0
+	for k := lo; float64(k) <= last; k++ {
+		term := d.PMF(k)
+		sum += term
+		if sum >= 1 {
+			return 1
+		}
+		if hi < 0 && float64(k) > mean && term <= 1e-17*sum {
+			break
+		}
+	}
+	return sum
+}
+
+// discreteQuantile 在支撑集 [lo, hi] 上求 F(k) ≥ p 的最小k，hi < 0 表示无上界
+func discreteQuantile(d DiscreteDistribution, lo, hi int, p float64) (float64, error) {
+	if err := checkProbability(p); err != nil {
+		return 0, err
+	}
+	if p == 1 && hi < 0 {
+		return math.Inf(1), nil
+	}
+	sum := 0.0
+	for k := lo; hi < 0 || k <= hi; k++ {
+		sum += d.PMF(k)
+		if sum >= p-1e-12 {
+			return float64(k), nil
+		}
+		if hi < 0 && k-lo > 1e7 {
+			return math.Inf(1), nil
+		}
+	}
+	return float64(hi), nil
+}
+
+// Bernoulli 两点分布：P(X = 1) = P，P(X = 0) = 1 - P
+type Bernoulli struct {
+	P float64
+}
+
+// NewBernoulli 构造两点分布
+func NewBernoulli(p float64) (Bernoulli, error) {
+	if err := checkProbability(p); err != nil {
+		return Bernoulli{}, err
+	}
+	return Bernoulli{P: p}, nil
+}
+
+// PMF P(X = k)
+func (d Bernoulli) PMF(k int) float64 {
+	switch k {
+	case 0:
+		return 1 - d.P
+	case 1:
+		return d.P
+	}
+	return 0
+}
+
+// CDF P(X ≤ x)
+func (d Bernoulli) CDF(x float64) float64 {
+	return discreteCDF(d, 0, 1, x)
+}
+
+// Quantile 分位数
+func (d Bernoulli) Quantile(p float64) (float64, error) {
+	return discreteQuantile(d, 0, 1, p)
+}
+
+// Mean E(X) = p
+func (d Bernoulli) Mean() float64 {
+	return d.P
+}
+
+// Variance D(X) = p(1 - p)
+func (d Bernoulli) Variance() float64 {
+	return d.P * (1 - d.P)
+}
+
+// Sample 抽样
+func (d Bernoulli) Sample(r *rand.Rand) float64 {
+	if r.Float64() < d.P {
+		return 1
+	}
+	return 0
+}
+
+// Binomial 二项分布 B(N, P)：N次独立重复试验中成功的次数
+type Binomial struct {
+	N int
+	P float64
+}
+
+// NewBinomial 构造二项分布
+func NewBinomial(n int, p float64) (Binomial, error) {
+	if n < 0 {
+		return Binomial{}, errors.New(errTrials)
+	}
+	if err := checkProbability(p); err != nil {
+		return Binomial{}, err
+	}
+	return Binomial{N: n, P: p}, nil
+}
+
+// PMF P(X = k) = C(n, k)pᵏ(1-p)ⁿ⁻ᵏ
+func (d Binomial) PMF(k int) float64 {
+	if k < 0 || k > d.N {
+		return 0
+	}
+	switch d.P {
+	case 0:
+		if k == 0 {
+			return 1
+		}
+		return 0
+	case 1:
+		if k == d.N {
+			return 1
+		}
+		return 0
+	}
+	if d.N <= exactChooseLimit {
+		return choose(d.N, k) * math.Pow(d.P, float64(k)) * math.Pow(1-d.P, float64(d.N-k))
+	}
+	return math.Exp(logChoose(d.N, k) + float64(k)*math.Log(d.P) + float64(d.N-k)*math.Log1p(-d.P))
+}
+
+// CDF P(X ≤ x)
+func (d Binomial) CDF(x float64) float64 {
+	return discreteCDF(d, 0, d.N, x)
+}
+
+// Quantile 分位数
+func (d Binomial) Quantile(p float64) (float64, error) {
+	return discreteQuantile(d, 0, d.N, p)
+}
+
+// Mean E(X) = np
+func (d Binomial) Mean() float64 {
+	return float64(d.N) * d.P
+}
+
+// Variance D(X) = np(1 - p)
+func (d Binomial) Variance() float64 {
+	return float64(d.N) * d.P * (1 - d.P)
+}
+
+// Sample 抽样
+func (d Binomial) Sample(r *rand.Rand) float64 {
+	x, _ := d.Quantile(r.Float64())
+	return x
+}
+
+// Hypergeometric 超几何分布 H(n, M, N)：N件产品中有M件次品，不放回地抽取n件，其中次品的件数
+type Hypergeometric struct {
+	Population, Successes, Draws int
+}
+
+// NewHypergeometric 构造超几何分布，参数依次为 N、M、n
+func NewHypergeometric(population, successes, draws int) (Hypergeometric, error) {
+	if population < 0 || successes < 0 || draws < 0 || successes > population || draws > population {
+		return Hypergeometric{}, errors.New(errHypergeometric)
+	}
+	return Hypergeometric{Population: population, Successes: successes, Draws: draws}, nil
+}
+
+func (d Hypergeometric) support() (int, int) {
+	return max(0, d.Draws-(d.Population-d.Successes)), min(d.Draws, d.Successes)
+}
+
+// PMF P(X = k) = C(M, k)C(N-M, n-k)/C(N, n)
+func (d Hypergeometric) PMF(k int) float64 {
+	lo, hi := d.support()
+	if k < lo || k > hi {
+		return 0
+	}
+	if d.Population <= exactChooseLimit {
+		return choose(d.Successes, k) * choose(d.Population-d.Successes, d.Draws-k) / choose(d.Population, d.Draws)
+	}
+	return math.Exp(logChoose(d.Successes, k) + logChoose(d.Population-d.Successes, d.Draws-k) - logChoose(d.Population, d.Draws))
+}
+
+// CDF P(X ≤ x)
+func (d Hypergeometric) CDF(x float64) float64 {
+	lo, hi := d.support()
+	return discreteCDF(d, lo, hi, x)
+}
+
+// Quantile 分位数
+func (d Hypergeometric) Quantile(p float64) (float64, error) {
+	lo, hi := d.support()
+	return discreteQuantile(d, lo, hi, p)
+}
+
+// Mean E(X) = nM/N
+func (d Hypergeometric) Mean() float64 {
+	if d.Population == 0 {
+		return 0
+	}
+	return float64(d.Draws) * float64(d.Successes) / float64(d.Population)
+}
+
+// Variance D(X) = nM(N-M)(N-n) / (N²(N-1))
+func (d Hypergeometric) Variance() float64 {
+	if d.Population <= 1 {
+		return 0
+	}
+	n, m, pop := float64(d.Draws), float64(d.Successes), float64(d.Population)
+	return n * m * (pop - m) * (pop - n) / (pop * pop * (pop - 1))
+}
+
+// Sample 抽样
+func (d Hypergeometric) Sample(r *rand.Rand) float64 {
+	x, _ := d.Quantile(r.Float64())
+	return x
+}
+
+// Geometric 几何分布：独立重复试验中首次成功所需的试验次数，P(X = k) = (1-p)ᵏ⁻¹p，k ≥ 1
+type Geometric struct {
+	P float64
+}
+
+// NewGeometric 构造几何分布
+func NewGeometric(p float64) (Geometric, error) {
+	if p <= 0 || p > 1 || math.IsNaN(p) {
+		return Geometric{}, errors.New(errGeometric)
+	}
+	return Geometric{P: p}, nil
+}
+
+// PMF P(X = k)
+func (d Geometric) PMF(k int) float64 {
+	if k < 1 {
+		return 0
+	}
+	return math.Exp(float64(k-1)*math.Log1p(-d.P)) * d.P
+}
+
+// CDF P(X ≤ x) = 1 - (1-p)^⌊x⌋，用expm1与log1p计算，p极小时1-p不会舍入成1
+func (d Geometric) CDF(x float64) float64 {
+	if math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x < 1 {
+		return 0
+	}
+	return -math.Expm1(math.Floor(x) * math.Log1p(-d.P))
+}
+
+// Quantile 分位数
+func (d Geometric) Quantile(p float64) (float64, error) {
+	if err := checkProbability(p); err != nil {
+		return 0, err
+	}
+	if p == 0 || d.P == 1 {
+		return 1, nil
+	}
+	if p == 1 {
+		return math.Inf(1), nil
+	}
+	k := math.Max(1, math.Ceil(math.Log1p(-p)/math.Log1p(-d.P)))
+	// 修正浮点误差造成的偏差，k很大时k±1已无法表示，只修正有限几步
+	for range 3 {
+		if k > 1 && d.CDF(k-1) >= p {
+			k--
+		} else if d.CDF(k) < p {
+			k++
+		} else {
+			break
+		}
+	}
+	return k, nil
+}
+
+// Mean E(X) = 1/p
+func (d Geometric) Mean() float64 {
+	return 1 / d.P
+}
+
+// Variance D(X) = (1-p)/p²
+func (d Geometric) Variance() float64 {
+	return (1 - d.P) / (d.P * d.P)
+}
+
+// Sample 抽样
+func (d Geometric) Sample(r *rand.Rand) float64 {
+	x, _ := d.Quantile(r.Float64())
+	return x
+}
+
+// Poisson 泊松分布 P(λ)：P(X = k) = λᵏe^(-λ)/k!
+type Poisson struct {
+	Lambda float64
+}
+
+// NewPoisson 构造泊松分布
+func NewPoisson(lambda float64) (Poisson, error) {
+	if !(lambda > 0) {
+		return Poisson{}, errors.New(errRate)
+	}
+	return Poisson{Lambda: lambda}, nil
+}
+
+// PMF P(X = k)
+func (d Poisson) PMF(k int) float64 {
+	if k < 0 {
+		return 0
+	}
+	lf, _ := math.Lgamma(float64(k + 1))
+	return math.Exp(float64(k)*math.Log(d.Lambda) - d.Lambda - lf)
+}
+
+// CDF P(X ≤ x)
+func (d Poisson) CDF(x float64) float64 {
+	return discreteCDF(d, 0, -1, x)
+}
+
+// Quantile 分位数
+func (d Poisson) Quantile(p float64) (float64, error) {
+	return discreteQuantile(d, 0, -1, p)
+}
+
+// Mean E(X) = λ
+func (d Poisson) Mean() float64 {
+	return d.Lambda
+}
+
+// Variance D(X) = λ
+func (d Poisson) Variance() float64 {
+	return d.Lambda
+}
+
+// Sample 抽样
+func (d Poisson) Sample(r *rand.Rand) float64 {
+	x, _ := d.Quantile(r.Float64())
+	return x
+}
+
+// Uniform 区间 [A, B] 上的均匀分布
+type Uniform struct {
+	A, B float64
+}
+
+// NewUniform 构造均匀分布
+func NewUniform(a, b float64) (Uniform, error) {
+	if !(a < b) {
+		return Uniform{}, errors.New(errUniformBounds)
+	}
+	return Uniform{A: a, B: b}, nil
+}
+
+// PDF 密度 1/(b-a)
+func (d Uniform) PDF(x float64) float64 {
+	if x < d.A || x > d.B {
+		return 0
+	}
+	return 1 / (d.B - d.A)
+}
+
+// CDF P(X ≤ x)
+func (d Uniform) CDF(x float64) float64 {
+	switch {
+	case x <= d.A:
+		return 0
+	case x >= d.B:
+		return 1
+	}
+	return (x - d.A) / (d.B - d.A)
+}
+
+// Quantile 分位数 a + p(b-a)
+func (d Uniform) Quantile(p float64) (float64, error) {
+	if err := checkProbability(p); err != nil {
+		return 0, err
+	}
+	return d.A + p*(d.B-d.A), nil
+}
+
+// Mean E(X) = (a+b)/2
+func (d Uniform) Mean() float64 {
+	return (d.A + d.B) / 2
+}
+
+// Variance D(X) = (b-a)²/12
+func (d Uniform) Variance() float64 {
+	return (d.B - d.A) * (d.B - d.A) / 12
+}
+
+// Sample 抽样
+func (d Uniform) Sample(r *rand.Rand) float64 {
+	return d.A + r.Float64()*(d.B-d.A)
+}
+
+// Normal 正态分布 N(μ, σ²)
+type Normal struct {
+	Mu, Sigma float64
+}
+
+// NewNormal 构造正态分布，sigma为标准差
+func NewNormal(mu, sigma float64) (Normal, error) {
+	if !(sigma > 0) {
+		return Normal{}, errors.New(errRate)
+	}
+	return Normal{Mu: mu, Sigma: sigma}, nil
+}
+
+// StandardNormal 标准正态分布 N(0, 1)
+func StandardNormal() Normal {
+	return Normal{Mu: 0, Sigma: 1}
+}
+
+// PDF 密度 e^(-(x-μ)²/2σ²) / (σ√(2π))
+func (d Normal) PDF(x float64) float64 {
+	z := (x - d.Mu) / d.Sigma
+	return math.Exp(-z*z/2) / (d.Sigma * math.Sqrt(2*math.Pi))
+}
+
+// CDF Φ((x-μ)/σ)
+func (d Normal) CDF(x float64) float64 {
+	return math.Erfc(-(x-d.Mu)/(d.Sigma*math.Sqrt2)) / 2
+}
+
+// Quantile 分位数 μ + σΦ⁻¹(p)
+func (d Normal) Quantile(p float64) (float64, error) {
+	if err := checkProbability(p); err != nil {
+		return 0, err
+	}
+	return d.Mu - d.Sigma*math.Sqrt2*math.Erfcinv(2*p), nil
+}
+
+// Mean E(X) = μ
+func (d Normal) Mean() float64 {
+	return d.Mu
+}
+
+// Variance D(X) = σ²
+func (d Normal) Variance() float64 {
+	return d.Sigma * d.Sigma
+}
+
+// Sample 抽样
+func (d Normal) Sample(r *rand.Rand) float64 {
+	return d.Mu + d.Sigma*r.NormFloat64()
+}
+
+// Exponential 指数分布：密度 λe^(-λx)，x ≥ 0
+type Exponential struct {
+	Lambda float64
+}
+
+// NewExponential 构造指数分布
+func NewExponential(lambda float64) (Exponential, error) {
+	if !(lambda > 0) {
+		return Exponential{}, errors.New(errRate)
+	}
+	return Exponential{Lambda: lambda}, nil
+}
+
+// PDF 密度
+func (d Exponential) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return d.Lambda * math.Exp(-d.Lambda*x)
+}
+
+// CDF 1 - e^(-λx)
+func (d Exponential) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return -math.Expm1(-d.Lambda * x)
+}
+
+// Quantile 分位数 -ln(1-p)/λ
+func (d Exponential) Quantile(p float64) (float64, error) {
+	if err := checkProbability(p); err != nil {
+		return 0, err
+	}
+	return -math.Log1p(-p) / d.Lambda, nil
+}
+
+// Mean E(X) = 1/λ
+func (d Exponential) Mean() float64 {
+	return 1 / d.Lambda
+}
+
+// Variance D(X) = 1/λ²
+func (d Exponential) Variance() float64 {
+	return 1 / (d.Lambda * d.Lambda)
+}
+
+// Sample 抽样
+func (d Exponential) Sample(r *rand.Rand) float64 {
+	return r.ExpFloat64() / d.Lambda
+}
+
+// DiscreteVariable 由分布列给出的离散型随机变量，取值按升序存储，相同取值的概率已合并
+type DiscreteVariable struct {
+	values []float64
+	probs  []float64
+}
+
+// NewDiscreteVariable 由取值与对应概率构造随机变量，校验各概率在[0, 1]内且和为1
+func NewDiscreteVariable(values, probs []float64) (DiscreteVariable, error) {
+	if len(values) == 0 || len(values) != len(probs) {
+		return DiscreteVariable{}, errors.New(errTableLength)
+	}
+	sum := 0.0
+	for _, p := range probs {
+		if err := checkProbability(p); err != nil {
+			return DiscreteVariable{}, err
+		}
+		sum += p
+	}
+	if math.Abs(sum-1) > probabilitySumTolerance {
+		return DiscreteVariable{}, errors.New(errProbabilitySum)
+	}
+	return newTable(values, probs), nil
+}
+
+// Tabulate 把取值于 [lo, hi] 的离散型分布列成表，如二项分布、超几何分布
+func Tabulate(d DiscreteDistribution, lo, hi int) (DiscreteVariable, error) {
+	var values, probs []float64
+	for k := lo; k <= hi; k++ {
+		values = append(values, float64(k))
+		probs = append(probs, d.PMF(k))
+	}
+	return NewDiscreteVariable(values, probs)
+}
+
+// newTable 排序并合并相同取值，浮点运算产生的相近取值视为相同
+func newTable(values, probs []float64) DiscreteVariable {
+	idx := make([]int, len(values))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return values[idx[a]] < values[idx[b]] })
+	var x DiscreteVariable
+	for _, i := range idx {
+		v, p := values[i], probs[i]
+		if n := len(x.values); n > 0 && math.Abs(v-x.values[n-1]) <= 1e-12*math.Max(1, math.Abs(v)) {
+			x.probs[n-1] += p
+			continue
+		}
+		x.values = append(x.values, v)
+		x.probs = append(x.probs, p)
+	}
+	return x
+}
+
+// Values 全部可能取值（升序）
+func (x DiscreteVariable) Values() []float64 {
+	return slices.Clone(x.values)
+}
+
+// Probabilities 与Values对应的概率
+func (x DiscreteVariable) Probabilities() []float64 {
+	return slices.Clone(x.probs)
+}
+
+// P 概率 P(X = v)
+func (x DiscreteVariable) P(v float64) float64 {
+	for i, value := range x.values {
+		if value == v {
+			return x.probs[i]
+		}
+	}
+	return 0
+}
+
+// CDF P(X ≤ v)
+func (x DiscreteVariable) CDF(v float64) float64 {
+	sum := 0.0
+	for i, value := range x.values {
+		if value > v {
+			break
+		}
+		sum += x.probs[i]
+	}
+	return math.Min(sum, 1)
+}
+
+// Quantile 分位数
+func (x DiscreteVariable) Quantile(p float64) (float64, error) {
+	if len(x.values) == 0 {
+		return 0, errors.New(dntExist)
+	}
+	if err := checkProbability(p); err != nil {
+		return 0, err
+	}
+	sum := 0.0
+	for i, value := range x.values {
+		sum += x.probs[i]
+		if sum >= p-probabilitySumTolerance && x.probs[i] > 0 {
+			return value, nil
+		}
+	}
+	return x.values[len(x.values)-1], nil
+}
+
+// ExpectationOf 随机变量函数的期望 E(g(X)) = Σg(xᵢ)pᵢ
+func (x DiscreteVariable) ExpectationOf(g func(float64) float64) float64 {
+	sum := 0.0
+	for i, value := range x.values {
+		sum += g(value) * x.probs[i]
+	}
+	return sum
+}
+
+// Mean 均值 E(X) = Σxᵢpᵢ
+func (x DiscreteVariable) Mean() float64 {
+	return x.ExpectationOf(func(v float64) float64 { return v })
+}
+
+// Variance 方差 D(X) = Σ(xᵢ - E(X))²pᵢ
+func (x DiscreteVariable) Variance() float64 {
+	mean := x.Mean()
+	return x.ExpectationOf(func(v float64) float64 { return (v - mean) * (v - mean) })
+}
+
+// StdDev 标准差 √D(X)
+func (x DiscreteVariable) StdDev() float64 {
+	return math.Sqrt(x.Variance())
+}
+
+// Linear 随机变量 aX + b 的分布列，满足 E(aX+b) = aE(X) + b，D(aX+b) = a²D(X)
+func (x DiscreteVariable) Linear(a, b float64) DiscreteVariable {
+	values := make([]float64, len(x.values))
+	for i, v := range x.values {
+		values[i] = a*v + b
+	}
+	return newTable(values, x.probs)
+}
+
+// Sample 抽样
+func (x DiscreteVariable) Sample(r *rand.Rand) float64 {
+	v, _ := x.Quantile(r.Float64())
+	return v
+}
+
+// combine 独立随机变量X、Y经运算op得到的新变量的分布列
+func combine(x, y DiscreteVariable, op func(a, b float64) float64) DiscreteVariable {
+	values := make([]float64, 0, len(x.values)*len(y.values))
+	probs := make([]float64, 0, cap(values))
+	for i, a := range x.values {
+		for j, b := range y.values {
+			values = append(values, op(a, b))
+			probs = append(probs, x.probs[i]*y.probs[j])
+		}
+	}
+	return newTable(values, probs)
+}
+
+// SumOfIndependent 独立随机变量之和 X + Y 的分布列（卷积）
+func SumOfIndependent(x, y DiscreteVariable) DiscreteVariable {
+	return combine(x, y, func(a, b float64) float64 { return a + b })
+}
+
+// ProductOfIndependent 独立随机变量之积 XY 的分布列，满足 E(XY) = E(X)E(Y)
+func ProductOfIndependent(x, y DiscreteVariable) DiscreteVariable {
+	return combine(x, y, func(a, b float64) float64 { return a * b })
+}
+
+// String 以两行表格输出分布列
+func (x DiscreteVariable) String() string {
+	top := []string{"X"}
+	bottom := []string{"P"}
+	for i, v := range x.values {
+		top = append(top, strconv.FormatFloat(v, 'g', -1, 64))
+		bottom = append(bottom, strconv.FormatFloat(x.probs[i], 'g', 6, 64))
+	}
+	var sb strings.Builder
+	for r, row := range [][]string{top, bottom} {
+		if r > 0 {
+			sb.WriteByte('\n')
+		}
+		for i, cell := range row {
+			width := max(len(top[i]), len(bottom[i]))
+			if i > 0 {
+				sb.WriteString(" | ")
+			}
+			sb.WriteString(cell)
+			if i < len(row)-1 {
+				sb.WriteString(strings.Repeat(" ", width-len(cell)))
+			}
+		}
+	}
+	return sb.String()
+}
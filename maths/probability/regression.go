@@ -9,3 +9,385 @@
 //"卡方公式"
 
 package probability
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	errSampleLength  = "样本x与y的长度须相同"
+	errTooFewPoints  = "样本点个数不足以确定回归方程"
+	errConstantX     = "x的取值全部相同，无法回归"
+	errPositiveData  = "该模型要求对应数据为正数"
+	errSingular      = "设计矩阵不满秩，回归系数不唯一"
+	errFeatureCount  = "自变量个数与回归方程不符"
+	errUnknownModel  = "未知的回归模型"
+	errNonFiniteData = "样本中含有非有限数"
+)
+
+const singularTolerance = 1e-10 // 消元时主元与矩阵最大元之比低于此值视为奇异
+
+// LinearFit 一元线性回归的结果，经验回归方程为 ŷ = Slope·x + Intercept
+type LinearFit struct {
+	Slope, Intercept float64
+	// R 样本相关系数
+	R float64
+	// RSquared 决定系数 R² = 1 - Σ(yᵢ - ŷᵢ)²/Σ(yᵢ - ȳ)²
+	RSquared float64
+	// Residuals 残差 eᵢ = yᵢ - ŷᵢ
+	Residuals []float64
+}
+
+func checkPairs(x, y []float64, least int) error {
+	if len(x) != len(y) {
+		return errors.New(errSampleLength)
+	}
+	if len(x) < least {
+		return errors.New(errTooFewPoints)
+	}
+	for i := range x {
+		if math.IsNaN(x[i]) || math.IsInf(x[i], 0) || math.IsNaN(y[i]) || math.IsInf(y[i], 0) {
+			return errors.New(errNonFiniteData)
+		}
+	}
+	return nil
+}
+
+// mean 算术平均，调用方保证切片非空
+func mean(data []float64) float64 {
+	sum := 0.0
+	for _, v := range data {
+		sum += v
+	}
+	return sum / float64(len(data))
+}
+
+// rSquared 决定系数，y全相同时拟合必然精确，约定为1
+func rSquared(y, residuals []float64) float64 {
+	avg := mean(y)
+	sse, sst := 0.0, 0.0
+	for i := range y {
+		sse += residuals[i] * residuals[i]
+		sst += (y[i] - avg) * (y[i] - avg)
+	}
+	if sst == 0 {
+		return 1
+	}
+	return 1 - sse/sst
+}
+
+// CorrelationCoefficient 样本相关系数 r = Σ(xᵢ-x̄)(yᵢ-ȳ) / √(Σ(xᵢ-x̄)²Σ(yᵢ-ȳ)²)
+func CorrelationCoefficient(x, y []float64) (float64, error) {
+	if err := checkPairs(x, y, 2); err != nil {
+		return 0, err
+	}
+	mx, my := mean(x), mean(y)
+	sxy, sxx, syy := 0.0, 0.0, 0.0
+	for i := range x {
+		dx, dy := x[i]-mx, y[i]-my
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	if sxx == 0 || syy == 0 {
+		return 0, errors.New(errConstantX)
+	}
+	return sxy / math.Sqrt(sxx*syy), nil
+}
+
+// LinearRegression 最小二乘法求经验回归方程
+// b̂ = Σ(xᵢ-x̄)(yᵢ-ȳ)/Σ(xᵢ-x̄)²，â = ȳ - b̂x̄
+func LinearRegression(x, y []float64) (LinearFit, error) {
+	if err := checkPairs(x, y, 2); err != nil {
+		return LinearFit{}, err
+	}
+	mx, my := mean(x), mean(y)
+	sxy, sxx, syy := 0.0, 0.0, 0.0
+	for i := range x {
+		dx, dy := x[i]-mx, y[i]-my
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	if sxx == 0 {
+		return LinearFit{}, errors.New(errConstantX)
+	}
+	fit := LinearFit{Slope: sxy / sxx}
+	fit.Intercept = my - fit.Slope*mx
+	if syy > 0 {
+		fit.R = sxy / math.Sqrt(sxx*syy)
+	}
+	fit.Residuals = make([]float64, len(x))
+	for i := range x {
+		fit.Residuals[i] = y[i] - fit.Predict(x[i])
+	}
+	fit.RSquared = rSquared(y, fit.Residuals)
+	return fit, nil
+}
+
+// Predict 预报值 ŷ = b̂x + â
+func (f LinearFit) Predict(x float64) float64 {
+	return f.Slope*x + f.Intercept
+}
+
+// String 经验回归方程
+func (f LinearFit) String() string {
+	return fmt.Sprintf("ŷ = %gx %s", f.Slope, signedTerm(f.Intercept))
+}
+
+func signedTerm(v float64) string {
+	if v < 0 {
+		return fmt.Sprintf("- %g", -v)
+	}
+	return fmt.Sprintf("+ %g", v)
+}
+
+// Model 可线性化的非线性回归模型
+type Model int
+
+const (
+	// ModelExponential y = A·e^(Bx)，取 ln y 后对x作线性回归
+	ModelExponential Model = iota
+	// ModelPower y = A·x^B，取 ln y 与 ln x 作线性回归
+	ModelPower
+	// ModelLogarithmic y = A + B·ln x，对 ln x 作线性回归
+	ModelLogarithmic
+)
+
+// String 模型的方程形式
+func (m Model) String() string {
+	switch m {
+	case ModelExponential:
+		return "y = A·e^(Bx)"
+	case ModelPower:
+		return "y = A·x^B"
+	case ModelLogarithmic:
+		return "y = A + B·ln x"
+	}
+	return "unknown"
+}
+
+// NonlinearFit 非线性回归的结果
+type NonlinearFit struct {
+	Model Model
+	A, B  float64
+	// Linearized 变换后数据的线性回归结果
+	Linearized LinearFit
+	// RSquared 在原始数据上计算的决定系数，可用于比较不同模型的拟合效果
+	RSquared float64
+	// Residuals 原始数据上的残差
+	Residuals []float64
+}
+
+// NonlinearRegression 通过变量代换把非线性模型化为线性回归求解
+func NonlinearRegression(model Model, x, y []float64) (NonlinearFit, error) {
+	if err := checkPairs(x, y, 2); err != nil {
+		return NonlinearFit{}, err
+	}
+	u := make([]float64, len(x))
+	v := make([]float64, len(y))
+	for i := range x {
+		u[i], v[i] = x[i], y[i]
+		switch model {
+		case ModelExponential:
+			if y[i] <= 0 {
+				return NonlinearFit{}, errors.New(errPositiveData)
+			}
+			v[i] = math.Log(y[i])
+		case ModelPower:
+			if x[i] <= 0 || y[i] <= 0 {
+				return NonlinearFit{}, errors.New(errPositiveData)
+			}
+			u[i], v[i] = math.Log(x[i]), math.Log(y[i])
+		case ModelLogarithmic:
+			if x[i] <= 0 {
+				return NonlinearFit{}, errors.New(errPositiveData)
+			}
+			u[i] = math.Log(x[i])
+		default:
+			return NonlinearFit{}, errors.New(errUnknownModel)
+		}
+	}
+	lin, err := LinearRegression(u, v)
+	if err != nil {
+		return NonlinearFit{}, err
+	}
+	fit := NonlinearFit{Model: model, Linearized: lin, B: lin.Slope, A: lin.Intercept}
+	if model != ModelLogarithmic {
+		fit.A = math.Exp(lin.Intercept)
+	}
+	fit.Residuals = make([]float64, len(x))
+	for i := range x {
+		fit.Residuals[i] = y[i] - fit.Predict(x[i])
+	}
+	fit.RSquared = rSquared(y, fit.Residuals)
+	return fit, nil
+}
+
+// Predict 预报值
+func (f NonlinearFit) Predict(x float64) float64 {
+	switch f.Model {
+	case ModelExponential:
+		return f.A * math.Exp(f.B*x)
+	case ModelPower:
+		return f.A * math.Pow(x, f.B)
+	case ModelLogarithmic:
+		return f.A + f.B*math.Log(x)
+	}
+	return math.NaN()
+}
+
+// String 回归方程
+func (f NonlinearFit) String() string {
+	switch f.Model {
+	case ModelExponential:
+		return fmt.Sprintf("ŷ = %g·e^(%gx)", f.A, f.B)
+	case ModelPower:
+		return fmt.Sprintf("ŷ = %g·x^%g", f.A, f.B)
+	case ModelLogarithmic:
+		return fmt.Sprintf("ŷ = %g %s·ln x", f.A, signedTerm(f.B))
+	}
+	return f.Model.String()
+}
+
+// MultipleFit 多元线性回归 ŷ = β₀ + β₁x₁ + … + βₖxₖ 的结果
+type MultipleFit struct {
+	// Coefficients 依次为 β₀（常数项）、β₁ … βₖ
+	Coefficients []float64
+	RSquared     float64
+	// AdjustedRSquared 调整决定系数 1 - (1-R²)(n-1)/(n-k-1)，样本量恰为k+1时为NaN
+	AdjustedRSquared float64
+	Residuals        []float64
+}
+
+// MultipleRegression 多元线性回归，xs[i]为第i个样本的各自变量取值
+// 各自变量先标准化为均值0、方差1，再求解正规方程组 ZᵀZb = Zᵀ(y - ȳ)，最后换算回原始尺度；
+// 标准化后 ZᵀZ/n 为相关系数矩阵，自变量近乎共线时主元接近零，返回不满秩错误
+func MultipleRegression(xs [][]float64, y []float64) (MultipleFit, error) {
+	if len(xs) != len(y) {
+		return MultipleFit{}, errors.New(errSampleLength)
+	}
+	if len(xs) == 0 {
+		return MultipleFit{}, errors.New(errTooFewPoints)
+	}
+	k := len(xs[0])
+	if len(xs) < k+1 {
+		return MultipleFit{}, errors.New(errTooFewPoints)
+	}
+	accs := make([]Accumulator, k)
+	for i, features := range xs {
+		if len(features) != k {
+			return MultipleFit{}, errors.New(errFeatureCount)
+		}
+		// 与一元回归的checkPairs一样拒绝NaN与±Inf
+		if math.IsNaN(y[i]) || math.IsInf(y[i], 0) {
+			return MultipleFit{}, errors.New(errNonFiniteData)
+		}
+		for j, v := range features {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return MultipleFit{}, errors.New(errNonFiniteData)
+			}
+			accs[j].Add(v)
+		}
+	}
+	n := float64(len(y))
+	means, scales := make([]float64, k), make([]float64, k)
+	for j := range accs {
+		means[j], _ = accs[j].Mean()
+		variance, _ := accs[j].PopulationVariance()
+		if scales[j] = math.Sqrt(variance); scales[j] == 0 {
+			// 常数自变量与截距项共线
+			return MultipleFit{}, errors.New(errSingular)
+		}
+	}
+	yMean := mean(y)
+	normal := make([][]float64, k)
+	for j := range normal {
+		normal[j] = make([]float64, k+1)
+	}
+	z := make([]float64, k)
+	for i, features := range xs {
+		for j, v := range features {
+			z[j] = (v - means[j]) / scales[j]
+		}
+		for a := range k {
+			for b := range k {
+				normal[a][b] += z[a] * z[b] / n
+			}
+			normal[a][k] += z[a] * (y[i] - yMean) / n
+		}
+	}
+	standardized, err := solveLinearSystem(normal)
+	if err != nil {
+		return MultipleFit{}, err
+	}
+	beta := make([]float64, k+1)
+	beta[0] = yMean
+	for j, b := range standardized {
+		beta[j+1] = b / scales[j]
+		beta[0] -= beta[j+1] * means[j]
+	}
+	fit := MultipleFit{Coefficients: beta, Residuals: make([]float64, len(y))}
+	for i, features := range xs {
+		pred, _ := fit.Predict(features...)
+		fit.Residuals[i] = y[i] - pred
+	}
+	fit.RSquared = rSquared(y, fit.Residuals)
+	fit.AdjustedRSquared = math.NaN()
+	if dof := n - float64(k) - 1; dof > 0 {
+		fit.AdjustedRSquared = 1 - (1-fit.RSquared)*(n-1)/dof
+	}
+	return fit, nil
+}
+
+// Predict 预报值，参数个数须等于自变量个数
+func (f MultipleFit) Predict(features ...float64) (float64, error) {
+	if len(features) != len(f.Coefficients)-1 {
+		return 0, errors.New(errFeatureCount)
+	}
+	sum := f.Coefficients[0]
+	for i, x := range features {
+		sum += f.Coefficients[i+1] * x
+	}
+	return sum, nil
+}
+
+// solveLinearSystem 列主元高斯消元求解增广矩阵对应的方程组，会修改传入的矩阵
+func solveLinearSystem(m [][]float64) ([]float64, error) {
+	n := len(m)
+	scale := 0.0
+	for _, row := range m {
+		for _, v := range row[:n] {
+			scale = math.Max(scale, math.Abs(v))
+		}
+	}
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(m[r][col]) > math.Abs(m[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(m[pivot][col]) <= singularTolerance*scale {
+			return nil, errors.New(errSingular)
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		for r := col + 1; r < n; r++ {
+			factor := m[r][col] / m[col][col]
+			for c := col; c <= n; c++ {
+				m[r][c] -= factor * m[col][c]
+			}
+		}
+	}
+	x := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		sum := m[r][n]
+		for c := r + 1; c < n; c++ {
+			sum -= m[r][c] * x[c]
+		}
+		x[r] = sum / m[r][r]
+	}
+	return x, nil
+}
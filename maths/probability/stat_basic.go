@@ -8,36 +8,16 @@ package probability
 
 import (
 	"errors"
-	"math"
-	"sort"
 )
 
 var (
-	dntPositive = "实参须为正数"
-	dntExist    = "切片须为非空集合"
+	dntExist = "切片须为非空集合"
 )
 
-func checker(a, b float64) bool {
-	if a >= 0 && b >= 0 {
-		return true
-	} else {
-		return false
-	}
-}
-
+// Percentile 按教材定义计算第p百分位数，p ∈ [0, 100]，不修改data
+// 其他定义见 PercentileBy
 func Percentile(p float64, data []float64) (float64, error) {
-	if checker(p, 0) {
-		sort.Float64s(data)
-		p /= 100
-		var i = float64(len(data)) * p
-		if i-math.Floor(i) < 1e-10 {
-			return (data[int(i)] + data[int(i)+1]) / 2, nil
-		} else {
-			return data[int(i)+1], nil
-		}
-	} else {
-		return 0, errors.New(dntPositive)
-	}
+	return PercentileBy(p, data, QuantileTextbook)
 }
 
 func SampleMean(sample []float64) (float64, error) {
@@ -52,18 +32,13 @@ func SampleMean(sample []float64) (float64, error) {
 	}
 }
 
+// SampleVariance 样本方差 s² = Σ(xᵢ-x̄)²/n，用Welford算法累加以避免大数相减的精度损失
+// 无偏估计 Σ(xᵢ-x̄)²/(n-1) 见 Accumulator.SampleVariance
 func SampleVariance(sample []float64) (float64, error) {
-	var squareSum float64
-	var sum float64
 	if len(sample) == 0 {
 		return 0, errors.New(dntExist)
-	} else {
-		for _, num := range sample {
-			squareSum += num * num
-			sum += num
-		}
-		var avgSquare = sum / float64(len(sample))
-		var squareAvg = squareSum / float64(len(sample))
-		return squareAvg - avgSquare, nil
 	}
+	var a Accumulator
+	a.Add(sample...)
+	return a.PopulationVariance()
 }
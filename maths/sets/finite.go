@@ -0,0 +1,117 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package sets
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FiniteSet 有限集，元素按升序保存且互不相同
+type FiniteSet[T cmp.Ordered] struct {
+	elems []T
+}
+
+const maxPowerSetSize = 20 // 幂集枚举允许的最大元素个数
+
+var (
+	errPowerSetTooLarge = "元素个数超过20，幂集过大无法枚举"
+)
+
+// NewFiniteSet 由若干元素构造有限集，重复元素只保留一个
+func NewFiniteSet[T cmp.Ordered](elems ...T) FiniteSet[T] {
+	list := slices.Clone(elems)
+	slices.Sort(list)
+	return FiniteSet[T]{elems: slices.Compact(list)}
+}
+
+// Elements 按升序返回全部元素
+func (s FiniteSet[T]) Elements() []T {
+	return slices.Clone(s.elems)
+}
+
+// Len 元素个数
+func (s FiniteSet[T]) Len() int {
+	return len(s.elems)
+}
+
+// Contains 判断x是否属于集合
+func (s FiniteSet[T]) Contains(x T) bool {
+	_, found := slices.BinarySearch(s.elems, x)
+	return found
+}
+
+// Union 并集
+func (s FiniteSet[T]) Union(t FiniteSet[T]) FiniteSet[T] {
+	return NewFiniteSet(append(s.Elements(), t.elems...)...)
+}
+
+// Intersect 交集
+func (s FiniteSet[T]) Intersect(t FiniteSet[T]) FiniteSet[T] {
+	var result []T
+	for _, x := range s.elems {
+		if t.Contains(x) {
+			result = append(result, x)
+		}
+	}
+	return FiniteSet[T]{elems: result}
+}
+
+// Difference 差集 s \ t
+func (s FiniteSet[T]) Difference(t FiniteSet[T]) FiniteSet[T] {
+	var result []T
+	for _, x := range s.elems {
+		if !t.Contains(x) {
+			result = append(result, x)
+		}
+	}
+	return FiniteSet[T]{elems: result}
+}
+
+// IsSubsetOf 判断s是否为t的子集
+func (s FiniteSet[T]) IsSubsetOf(t FiniteSet[T]) bool {
+	return s.Difference(t).Len() == 0
+}
+
+// Equal 判断两个有限集是否相等
+func (s FiniteSet[T]) Equal(t FiniteSet[T]) bool {
+	return slices.Equal(s.elems, t.elems)
+}
+
+// PowerSet 枚举全部2ⁿ个子集，按二进制掩码顺序排列（第一个为空集，最后一个为全集）
+func (s FiniteSet[T]) PowerSet() ([]FiniteSet[T], error) {
+	n := len(s.elems)
+	if n > maxPowerSetSize {
+		return nil, errors.New(errPowerSetTooLarge)
+	}
+	result := make([]FiniteSet[T], 0, 1<<n)
+	for mask := 0; mask < 1<<n; mask++ {
+		var subset []T
+		for i, x := range s.elems {
+			if mask&(1<<i) != 0 {
+				subset = append(subset, x)
+			}
+		}
+		result = append(result, FiniteSet[T]{elems: subset})
+	}
+	return result, nil
+}
+
+// String 有限集的文本形式，如 {1, 2, 3}
+func (s FiniteSet[T]) String() string {
+	if len(s.elems) == 0 {
+		return "∅"
+	}
+	parts := make([]string, len(s.elems))
+	for i, x := range s.elems {
+		parts[i] = fmt.Sprint(x)
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
@@ -0,0 +1,253 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package sets
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Interval 实数区间，端点可为±Inf，无穷端点总是开的
+type Interval struct {
+	Left        float64
+	Right       float64
+	LeftClosed  bool
+	RightClosed bool
+}
+
+// IntervalSet 若干互不相交区间的并，内部按左端点升序保存
+type IntervalSet struct {
+	intervals []Interval
+}
+
+// Open 开区间(a, b)
+func Open(a, b float64) Interval {
+	return Interval{Left: a, Right: b}
+}
+
+// Closed 闭区间[a, b]
+func Closed(a, b float64) Interval {
+	return Interval{Left: a, Right: b, LeftClosed: true, RightClosed: true}
+}
+
+// Point 单点集{x}
+func Point(x float64) Interval {
+	return Closed(x, x)
+}
+
+// Reals 全体实数(-∞, +∞)
+func Reals() IntervalSet {
+	return NewIntervalSet(Open(math.Inf(-1), math.Inf(1)))
+}
+
+// IsEmpty 判断区间是否为空
+func (i Interval) IsEmpty() bool {
+	if i.Left > i.Right || math.IsNaN(i.Left) || math.IsNaN(i.Right) {
+		return true
+	}
+	return i.Left == i.Right && !(i.LeftClosed && i.RightClosed)
+}
+
+// Contains 判断x是否属于区间
+func (i Interval) Contains(x float64) bool {
+	if x < i.Left || x > i.Right || math.IsNaN(x) {
+		return false
+	}
+	if x == i.Left && !i.LeftClosed {
+		return false
+	}
+	return x != i.Right || i.RightClosed
+}
+
+// normalize 无穷端点一律改为开端点
+func (i Interval) normalize() Interval {
+	if math.IsInf(i.Left, 0) {
+		i.LeftClosed = false
+	}
+	if math.IsInf(i.Right, 0) {
+		i.RightClosed = false
+	}
+	return i
+}
+
+// String 区间的文本形式，如 [1, 2)、(-∞, 0]、{3}
+func (i Interval) String() string {
+	if i.IsEmpty() {
+		return "∅"
+	}
+	if i.Left == i.Right {
+		return "{" + formatEnd(i.Left) + "}"
+	}
+	left, right := "(", ")"
+	if i.LeftClosed {
+		left = "["
+	}
+	if i.RightClosed {
+		right = "]"
+	}
+	return left + formatEnd(i.Left) + ", " + formatEnd(i.Right) + right
+}
+
+// formatEnd 输出端点，无穷记为∞
+func formatEnd(x float64) string {
+	switch {
+	case math.IsInf(x, 1):
+		return "+∞"
+	case math.IsInf(x, -1):
+		return "-∞"
+	}
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+// NewIntervalSet 由若干区间构造区间集，自动去掉空区间并合并相交或相接的区间
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	var list []Interval
+	for _, i := range intervals {
+		i = i.normalize()
+		if !i.IsEmpty() {
+			list = append(list, i)
+		}
+	}
+	sort.Slice(list, func(a, b int) bool {
+		if list[a].Left != list[b].Left {
+			return list[a].Left < list[b].Left
+		}
+		return list[a].LeftClosed && !list[b].LeftClosed
+	})
+	var merged []Interval
+	for _, i := range list {
+		n := len(merged)
+		if n == 0 || !touches(merged[n-1], i) {
+			merged = append(merged, i)
+			continue
+		}
+		last := &merged[n-1]
+		if i.Right > last.Right || (i.Right == last.Right && i.RightClosed) {
+			last.Right, last.RightClosed = i.Right, i.RightClosed
+		}
+	}
+	return IntervalSet{intervals: merged}
+}
+
+// touches 判断按左端点排序后相邻的两个区间能否合并
+func touches(a, b Interval) bool {
+	if b.Left < a.Right {
+		return true
+	}
+	return b.Left == a.Right && (a.RightClosed || b.LeftClosed)
+}
+
+// Intervals 返回构成区间集的各个区间
+func (s IntervalSet) Intervals() []Interval {
+	return append([]Interval{}, s.intervals...)
+}
+
+// IsEmpty 判断是否为空集
+func (s IntervalSet) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Union 并集
+func (s IntervalSet) Union(t IntervalSet) IntervalSet {
+	return NewIntervalSet(append(s.Intervals(), t.intervals...)...)
+}
+
+// Intersect 交集
+func (s IntervalSet) Intersect(t IntervalSet) IntervalSet {
+	var result []Interval
+	for _, a := range s.intervals {
+		for _, b := range t.intervals {
+			result = append(result, intersect(a, b))
+		}
+	}
+	return NewIntervalSet(result...)
+}
+
+// intersect 两个区间的交
+func intersect(a, b Interval) Interval {
+	result := a
+	if b.Left > a.Left || (b.Left == a.Left && !b.LeftClosed) {
+		result.Left, result.LeftClosed = b.Left, b.LeftClosed
+	}
+	if b.Right < a.Right || (b.Right == a.Right && !b.RightClosed) {
+		result.Right, result.RightClosed = b.Right, b.RightClosed
+	}
+	return result
+}
+
+// Complement 在实数集中的补集
+func (s IntervalSet) Complement() IntervalSet {
+	var result []Interval
+	left, leftClosed := math.Inf(-1), false
+	for _, i := range s.intervals {
+		result = append(result, Interval{
+			Left:        left,
+			Right:       i.Left,
+			LeftClosed:  leftClosed,
+			RightClosed: !i.LeftClosed,
+		})
+		left, leftClosed = i.Right, !i.RightClosed
+	}
+	result = append(result, Interval{Left: left, Right: math.Inf(1), LeftClosed: leftClosed})
+	return NewIntervalSet(result...)
+}
+
+// Difference 差集 s \ t
+func (s IntervalSet) Difference(t IntervalSet) IntervalSet {
+	return s.Intersect(t.Complement())
+}
+
+// Contains 判断x是否属于区间集
+func (s IntervalSet) Contains(x float64) bool {
+	for _, i := range s.intervals {
+		if i.Contains(x) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSubsetOf 判断s是否为t的子集
+func (s IntervalSet) IsSubsetOf(t IntervalSet) bool {
+	return s.Difference(t).IsEmpty()
+}
+
+// Equal 判断两个区间集是否相等
+func (s IntervalSet) Equal(t IntervalSet) bool {
+	if len(s.intervals) != len(t.intervals) {
+		return false
+	}
+	for i := range s.intervals {
+		if s.intervals[i] != t.intervals[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Points 由若干实数构成的离散点集
+func Points(xs ...float64) IntervalSet {
+	intervals := make([]Interval, len(xs))
+	for i, x := range xs {
+		intervals[i] = Point(x)
+	}
+	return NewIntervalSet(intervals...)
+}
+
+// String 区间集的文本形式，如 (-∞, 1) ∪ [2, 3]
+func (s IntervalSet) String() string {
+	if s.IsEmpty() {
+		return "∅"
+	}
+	parts := make([]string, len(s.intervals))
+	for i, interval := range s.intervals {
+		parts[i] = interval.String()
+	}
+	return strings.Join(parts, " ∪ ")
+}
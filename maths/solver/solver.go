@@ -0,0 +1,340 @@
+/**
+ * Author:  Nyxvectar Yan
+ * Repo:    guts
+ * Created: 10/17/2026
+ */
+
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Options 迭代求根的参数，零值字段使用默认值
+type Options struct {
+	Tolerance     float64 // 根的绝对精度，默认1e-12；另附加与|x|成正比的相对精度，大根也能收敛
+	MaxIterations int     // 最大迭代次数，默认200
+}
+
+// Method 区间扫描后在每个有根区间内使用的求根方法
+type Method int
+
+const (
+	MethodBrent Method = iota
+	MethodBisection
+)
+
+// ConvergenceError 迭代在次数上限内未收敛，记录最后一次的近似根与残差
+type ConvergenceError struct {
+	Method     string
+	Iterations int
+	X          float64
+	Residual   float64
+}
+
+func (e *ConvergenceError) Error() string {
+	return fmt.Sprintf("%s迭代%d次后仍未收敛，当前x=%v，|f(x)|=%v", e.Method, e.Iterations, e.X, e.Residual)
+}
+
+var (
+	errNoBracket      = "区间端点的函数值须异号"
+	errBadInterval    = "区间左端点须小于右端点"
+	errZeroDerivative = "导数为零，牛顿迭代无法继续"
+	errSameGuess      = "割线法的两个初值的函数值不得相同"
+	errUndefined      = "函数在x=%v处没有定义"
+	errSamples        = "扫描的分段数须为正整数"
+	errBracketFailed  = "区间[%v, %v]内求根失败：%w"
+)
+
+// epsilon float64的机器精度 2⁻⁵²
+const epsilon = 0x1p-52
+
+// withDefaults 补全零值参数
+func (o Options) withDefaults() Options {
+	if o.Tolerance <= 0 {
+		o.Tolerance = 1e-12
+	}
+	if o.MaxIterations <= 0 {
+		o.MaxIterations = 200
+	}
+	return o
+}
+
+// checkBracket 检查[a, b]两端函数值异号，端点恰为根时直接返回该根
+func checkBracket(f func(float64) float64, a, b float64) (float64, float64, bool, error) {
+	if a >= b {
+		return 0, 0, false, errors.New(errBadInterval)
+	}
+	fa, fb := f(a), f(b)
+	if math.IsNaN(fa) {
+		return 0, 0, false, fmt.Errorf(errUndefined, a)
+	}
+	if math.IsNaN(fb) {
+		return 0, 0, false, fmt.Errorf(errUndefined, b)
+	}
+	if fa == 0 || fb == 0 {
+		return fa, fb, true, nil
+	}
+	if (fa > 0) == (fb > 0) {
+		return 0, 0, false, errors.New(errNoBracket)
+	}
+	return fa, fb, false, nil
+}
+
+// Bisection 二分法：要求f(a)与f(b)异号
+func Bisection(f func(float64) float64, a, b float64, opts Options) (float64, error) {
+	opts = opts.withDefaults()
+	fa, _, endpoint, err := checkBracket(f, a, b)
+	if err != nil {
+		return 0, err
+	}
+	if endpoint {
+		return pickEndpoint(a, b, fa), nil
+	}
+	for i := 0; i < opts.MaxIterations; i++ {
+		m := a + (b-a)/2
+		fm := f(m)
+		if fm == 0 || (b-a)/2 < opts.Tolerance+4*epsilon*math.Abs(m) || m == a || m == b {
+			return m, nil
+		}
+		if (fm > 0) == (fa > 0) {
+			a, fa = m, fm
+		} else {
+			b = m
+		}
+	}
+	m := a + (b-a)/2
+	return m, &ConvergenceError{Method: "二分法", Iterations: opts.MaxIterations, X: m, Residual: math.Abs(f(m))}
+}
+
+// Newton 牛顿迭代 xₙ₊₁ = xₙ - f(xₙ)/f'(xₙ)，df为nil时用中心差商近似导数
+func Newton(f, df func(float64) float64, x0 float64, opts Options) (float64, error) {
+	opts = opts.withDefaults()
+	if df == nil {
+		df = func(x float64) float64 {
+			h := 1e-6 * math.Max(1, math.Abs(x))
+			return (f(x+h) - f(x-h)) / (2 * h)
+		}
+	}
+	x := x0
+	for i := 0; i < opts.MaxIterations; i++ {
+		fx, dfx := f(x), df(x)
+		if math.IsNaN(fx) || math.IsNaN(dfx) {
+			return 0, fmt.Errorf(errUndefined, x)
+		}
+		if fx == 0 {
+			return x, nil
+		}
+		if dfx == 0 {
+			return x, errors.New(errZeroDerivative)
+		}
+		step := fx / dfx
+		x -= step
+		if math.Abs(step) < opts.Tolerance {
+			return x, nil
+		}
+	}
+	return x, &ConvergenceError{Method: "牛顿法", Iterations: opts.MaxIterations, X: x, Residual: math.Abs(f(x))}
+}
+
+// Secant 割线法：以两点连线的零点代替切线的零点，无需导数
+func Secant(f func(float64) float64, x0, x1 float64, opts Options) (float64, error) {
+	opts = opts.withDefaults()
+	f0, f1 := f(x0), f(x1)
+	for i := 0; i < opts.MaxIterations; i++ {
+		if math.IsNaN(f1) {
+			return 0, fmt.Errorf(errUndefined, x1)
+		}
+		if f1 == 0 {
+			return x1, nil
+		}
+		if f1 == f0 {
+			return x1, errors.New(errSameGuess)
+		}
+		x2 := x1 - f1*(x1-x0)/(f1-f0)
+		if math.Abs(x2-x1) < opts.Tolerance {
+			return x2, nil
+		}
+		x0, f0 = x1, f1
+		x1, f1 = x2, f(x2)
+	}
+	return x1, &ConvergenceError{Method: "割线法", Iterations: opts.MaxIterations, X: x1, Residual: math.Abs(f1)}
+}
+
+// Brent 布伦特方法：结合二分、割线与逆二次插值，要求f(a)与f(b)异号，收敛有保证且通常很快
+func Brent(f func(float64) float64, a, b float64, opts Options) (float64, error) {
+	opts = opts.withDefaults()
+	fa, fb, endpoint, err := checkBracket(f, a, b)
+	if err != nil {
+		return 0, err
+	}
+	if endpoint {
+		return pickEndpoint(a, b, fa), nil
+	}
+	c, fc := a, fa
+	d := b - a
+	e := d
+	for i := 0; i < opts.MaxIterations; i++ {
+		if (fb > 0) == (fc > 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol := 2*epsilon*math.Abs(b) + opts.Tolerance/2
+		m := (c - b) / 2
+		if math.Abs(m) <= tol || fb == 0 {
+			return b, nil
+		}
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// 割线步
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				// 逆二次插值步
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*m*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			if 2*p < math.Min(3*m*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e, d = d, p/q
+			} else {
+				d, e = m, m
+			}
+		} else {
+			// 插值不可靠时退回二分
+			d, e = m, m
+		}
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, m)
+		}
+		fb = f(b)
+		if math.IsNaN(fb) {
+			return 0, fmt.Errorf(errUndefined, b)
+		}
+	}
+	return b, &ConvergenceError{Method: "布伦特方法", Iterations: opts.MaxIterations, X: b, Residual: math.Abs(fb)}
+}
+
+// pickEndpoint 从端点中选出函数值为零的一个
+func pickEndpoint(a, b, fa float64) float64 {
+	if fa == 0 {
+		return a
+	}
+	return b
+}
+
+const (
+	discontinuityThreshold = 1e-6  // 根的残差超过此值乘以区间端点函数值的量级时视为间断点
+	tangencyThreshold      = 1e-10 // |f|的局部极小值不超过此值时视为不变号的重根
+)
+
+// FindAllRoots 将[a, b]等分为samples段逐段扫描，在每个变号区间内求根，返回全部根（升序）
+// 不变号的重根（如x²的零点）通过|f|的局部极小值寻找，只要相邻分点能把它与其他零点隔开即可
+// 个别区间求根失败时不中断扫描，仍返回已求得的根，同时返回汇总各区间失败原因的错误
+func FindAllRoots(f func(float64) float64, a, b float64, samples int, method Method, opts Options) ([]float64, error) {
+	if a >= b {
+		return nil, errors.New(errBadInterval)
+	}
+	if samples <= 0 {
+		return nil, errors.New(errSamples)
+	}
+	opts = opts.withDefaults()
+	solve := Brent
+	if method == MethodBisection {
+		solve = Bisection
+	}
+	xs := make([]float64, samples+1)
+	ys := make([]float64, samples+1)
+	for i := range xs {
+		xs[i] = a + (b-a)*float64(i)/float64(samples)
+		ys[i] = f(xs[i])
+	}
+	var roots []float64
+	var failures []error
+	for i, y := range ys {
+		if y == 0 {
+			roots = append(roots, xs[i])
+		}
+	}
+	for i := 1; i <= samples; i++ {
+		prev, y := ys[i-1], ys[i]
+		if y == 0 || prev == 0 || math.IsNaN(y) || math.IsNaN(prev) {
+			// 分点恰为根的已计入，定义域间断处跳过
+			continue
+		}
+		if (y > 0) != (prev > 0) {
+			root, err := solve(f, xs[i-1], xs[i], opts)
+			if err != nil {
+				failures = append(failures, fmt.Errorf(errBracketFailed, xs[i-1], xs[i], err))
+				continue
+			}
+			// 跨过间断点（如tan x的渐近线）时函数同样变号，收敛处残差很大，不算作根
+			scale := math.Max(1, math.Max(math.Abs(prev), math.Abs(y)))
+			if math.Abs(f(root)) <= discontinuityThreshold*scale {
+				roots = append(roots, root)
+			}
+			continue
+		}
+		if i < samples && isTouchCandidate(prev, y, ys[i+1]) {
+			if root, ok := touchRoot(f, xs[i-1], xs[i+1], opts); ok {
+				roots = append(roots, root)
+			}
+		}
+	}
+	sort.Float64s(roots)
+	return roots, errors.Join(failures...)
+}
+
+// isTouchCandidate 三个同号分点中间的|f|最小时，可能夹着一个不变号的重根
+func isTouchCandidate(y0, y1, y2 float64) bool {
+	if math.IsNaN(y2) || y2 == 0 || (y0 > 0) != (y1 > 0) || (y1 > 0) != (y2 > 0) {
+		return false
+	}
+	return math.Abs(y1) < math.Abs(y0) && math.Abs(y1) < math.Abs(y2)
+}
+
+// touchRoot 黄金分割法求|f|在[a, b]上的极小值点，极小值足够接近零时视为重根
+func touchRoot(f func(float64) float64, a, b float64, opts Options) (float64, bool) {
+	const ratio = 0.6180339887498949
+	g := func(x float64) float64 { return math.Abs(f(x)) }
+	x1, x2 := b-ratio*(b-a), a+ratio*(b-a)
+	g1, g2 := g(x1), g(x2)
+	for i := 0; i < opts.MaxIterations && b-a > opts.Tolerance; i++ {
+		if g1 < g2 {
+			b, x2, g2 = x2, x1, g1
+			x1 = b - ratio*(b-a)
+			g1 = g(x1)
+		} else {
+			a, x1, g1 = x1, x2, g2
+			x2 = a + ratio*(b-a)
+			g2 = g(x2)
+		}
+	}
+	x := (a + b) / 2
+	return x, g(x) <= tangencyThreshold
+}
+
+// CountRoots 统计f在[a, b]上的零点个数，返回错误时计数只包含已求得的根
+func CountRoots(f func(float64) float64, a, b float64, samples int) (int, error) {
+	roots, err := FindAllRoots(f, a, b, samples, MethodBrent, Options{})
+	return len(roots), err
+}